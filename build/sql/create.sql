@@ -1,17 +1,303 @@
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
+-- Great-circle distance between two points in kilometers (haversine).
+CREATE OR REPLACE FUNCTION earth_distance_km(lat1 DOUBLE PRECISION, lon1 DOUBLE PRECISION, lat2 DOUBLE PRECISION, lon2 DOUBLE PRECISION)
+RETURNS DOUBLE PRECISION
+LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE
+RETURN 2 * 6371.0088 * asin(sqrt(least(1,
+    sin(radians(lat2 - lat1) / 2) ^ 2 +
+    cos(radians(lat1)) * cos(radians(lat2)) * sin(radians(lon2 - lon1) / 2) ^ 2
+)));
+
 CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     login TEXT NOT NULL UNIQUE,                                                 
-    password_hash BYTEA NOT NULL   
+    password_hash BYTEA NOT NULL,
+    is_admin BOOLEAN NOT NULL DEFAULT false,
+    -- the number of rows in saved_searches, the limit is checked against it
+    -- under the lock of the user row
+    saved_searches_count INT NOT NULL DEFAULT 0 CHECK (saved_searches_count >= 0)
+);
+
+CREATE TABLE IF NOT EXISTS categories (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    parent_id UUID REFERENCES categories(id),
+    name VARCHAR(100) NOT NULL,
+    attributes JSONB NOT NULL DEFAULT '[]',
+    UNIQUE NULLS NOT DISTINCT (parent_id, name)
+);
+
+-- rate is the price of one unit of currency in rubles, prices of ads are
+-- converted through it. Rubles are always there with the rate of 1.
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    currency TEXT PRIMARY KEY CHECK (currency ~ '^[A-Z]{3}$'),
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
 );
 
+INSERT INTO exchange_rates (currency, rate) VALUES ('RUB', 1) ON CONFLICT DO NOTHING;
+
+-- Converts an amount in minor units between currencies through their rates
+-- to rubles. NULL when one of the currencies has no rate.
+CREATE OR REPLACE FUNCTION convert_price(amount BIGINT, from_currency TEXT, to_currency TEXT)
+RETURNS BIGINT
+LANGUAGE SQL STABLE STRICT PARALLEL SAFE
+RETURN CASE WHEN from_currency = to_currency THEN amount
+    ELSE round(amount * (SELECT rate FROM exchange_rates WHERE currency = from_currency)
+        / (SELECT rate FROM exchange_rates WHERE currency = to_currency))::BIGINT
+END;
+
+-- Share of the previous price an ad got cheaper by, NULL unless the price
+-- went down. Prices in different currencies are compared in rubles.
+CREATE OR REPLACE FUNCTION price_drop(price BIGINT, currency TEXT, previous_price BIGINT, previous_currency TEXT)
+RETURNS NUMERIC
+LANGUAGE SQL STABLE STRICT PARALLEL SAFE
+RETURN CASE WHEN convert_price(price, currency, 'RUB') < convert_price(previous_price, previous_currency, 'RUB')
+    THEN 1 - convert_price(price, currency, 'RUB')::NUMERIC / convert_price(previous_price, previous_currency, 'RUB')
+END;
+
 CREATE TABLE IF NOT EXISTS ads (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     user_id UUID NOT NULL REFERENCES users(id),
+    category_id UUID NOT NULL REFERENCES categories(id),
     title VARCHAR(100) NOT NULL,
     description TEXT,
     price INT NOT NULL,
-    image_url TEXT DEFAULT 'default_product.jpg',
+    currency TEXT NOT NULL DEFAULT 'RUB' REFERENCES exchange_rates(currency),
+    -- price converted to rubles at the current rate, rewritten on every
+    -- change of the price or the rate so prices can be filtered and sorted
+    -- with an index
+    base_price BIGINT NOT NULL,
+    -- the price before the last change, the currency is not a foreign key
+    -- so old prices do not keep a currency from being removed
+    previous_price INT,
+    previous_currency TEXT,
+    CHECK ((previous_price IS NULL) = (previous_currency IS NULL)),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    version INT NOT NULL DEFAULT 1,
+    status TEXT NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'pending_media', 'published', 'reserved', 'sold', 'archived', 'rejected')),
+    expires_at TIMESTAMPTZ NOT NULL DEFAULT now() + INTERVAL '30 days',
+    deleted_at TIMESTAMPTZ,
+    attributes JSONB NOT NULL DEFAULT '{}',
+    city VARCHAR(100) NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    CHECK ((latitude IS NULL) = (longitude IS NULL)),
+    -- the number of rows in favorites, kept by insertFavorite and
+    -- deleteFavorite so lists do not count them for every ad
+    favorites_count INT NOT NULL DEFAULT 0 CHECK (favorites_count >= 0),
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED
+);
+
+CREATE TABLE IF NOT EXISTS images (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id),
+    content_type TEXT NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    size_bytes INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Positions are unique and there is at most one cover per ad. Both checks
+-- are deferred, so a gallery can be reordered by a single UPDATE.
+CREATE TABLE IF NOT EXISTS ad_images (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    image_id UUID REFERENCES images(id),
+    position INT NOT NULL CHECK (position >= 0),
+    is_cover BOOLEAN NOT NULL DEFAULT false,
+    UNIQUE (ad_id, position) DEFERRABLE INITIALLY DEFERRED,
+    EXCLUDE USING btree (ad_id WITH =) WHERE (is_cover) DEFERRABLE INITIALLY DEFERRED
+);
+
+-- Remote images of new ads are checked in the background. A pending check
+-- is leased by a worker through next_attempt_at, a rejected one is kept so
+-- the owner can see the reason.
+CREATE TABLE IF NOT EXISTS ad_media_checks (
+    ad_id UUID PRIMARY KEY REFERENCES ads(id) ON DELETE CASCADE,
+    target_status TEXT NOT NULL,
+    state TEXT NOT NULL DEFAULT 'pending' CHECK (state IN ('pending', 'rejected')),
+    reason TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    checked_at TIMESTAMPTZ
+);
+
+-- Every price an ad had, starting with the one it was created with.
+CREATE TABLE IF NOT EXISTS ad_price_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    price INT NOT NULL,
+    currency TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS favorites (
+    user_id UUID NOT NULL REFERENCES users(id),
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, ad_id)
+);
+
+-- A GetAds filter saved by a user. query is the query string the filter was
+-- parsed from, the other columns repeat its conditions for the matcher. The
+-- category and the currency are not foreign keys, so they can be removed
+-- while searches refer to them; such searches just stop matching.
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    name VARCHAR(100) NOT NULL,
+    query TEXT NOT NULL,
+    search_text TEXT NOT NULL DEFAULT '',
+    search_tsquery TSQUERY GENERATED ALWAYS AS (
+        CASE WHEN search_text = '' THEN NULL
+            ELSE websearch_to_tsquery('russian', search_text) || websearch_to_tsquery('simple', search_text)
+        END
+    ) STORED,
+    price_min BIGINT NOT NULL DEFAULT 0,
+    price_max BIGINT NOT NULL DEFAULT 0,
+    currency TEXT NOT NULL DEFAULT 'RUB',
+    -- price_min and price_max in rubles at the current rate, rewritten when
+    -- the rate changes, so the matcher finds searches for a price with an
+    -- index instead of converting the price for every search
+    base_price_range INT8RANGE NOT NULL,
+    category_id UUID,
+    author_id UUID,
+    author_login TEXT NOT NULL DEFAULT '',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    tags_all BOOLEAN NOT NULL DEFAULT false,
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    radius_km DOUBLE PRECISION NOT NULL DEFAULT 0,
+    attributes JSONB NOT NULL DEFAULT '[]',
     created_at TIMESTAMPTZ NOT NULL DEFAULT now()
-);
\ No newline at end of file
+);
+
+-- New ads wait here until the matcher compares them with saved searches.
+CREATE TABLE IF NOT EXISTS search_match_queue (
+    ad_id UUID PRIMARY KEY REFERENCES ads(id) ON DELETE CASCADE,
+    queued_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- One notification per user and ad, however many searches of the user match.
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    search_id UUID NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, ad_id)
+);
+
+-- A thread between a buyer and the seller of an ad. The seller is the owner
+-- of the ad, nobody writes to themselves.
+CREATE TABLE IF NOT EXISTS conversations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    buyer_id UUID NOT NULL REFERENCES users(id),
+    seller_id UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (buyer_id <> seller_id),
+    UNIQUE (ad_id, buyer_id, seller_id)
+);
+
+-- read_at is set once the other side of the conversation fetched the message.
+CREATE TABLE IF NOT EXISTS messages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    conversation_id UUID NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    sender_id UUID NOT NULL REFERENCES users(id),
+    text TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    read_at TIMESTAMPTZ
+);
+
+-- One row per ad, kind, viewer and dedup window, so a viewer is counted once
+-- per window. A view is an opened ad page, an impression is the ad shown in
+-- the results of GetAds. Rows of past windows are purged, the counts stay
+-- in ad_daily_stats.
+CREATE TABLE IF NOT EXISTS ad_views (
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('view', 'impression')),
+    viewer TEXT NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (ad_id, kind, viewer, window_start)
+);
+
+-- day is a UTC date.
+CREATE TABLE IF NOT EXISTS ad_daily_stats (
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    views INT NOT NULL DEFAULT 0,
+    impressions INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (ad_id, day)
+);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(30) NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS ad_tags (
+    ad_id UUID NOT NULL REFERENCES ads(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id),
+    PRIMARY KEY (ad_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS ads_deleted_at_idx ON ads (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS ads_status_idx ON ads (status) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS ads_expires_at_idx ON ads (expires_at)
+    WHERE status IN ('published', 'reserved') AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS ads_search_vector_idx ON ads USING GIN (search_vector);
+
+CREATE INDEX IF NOT EXISTS ads_category_id_idx ON ads (category_id);
+
+CREATE INDEX IF NOT EXISTS categories_parent_id_idx ON categories (parent_id);
+
+CREATE INDEX IF NOT EXISTS ads_attributes_idx ON ads USING GIN (attributes jsonb_path_ops);
+
+CREATE INDEX IF NOT EXISTS ad_tags_tag_id_idx ON ad_tags (tag_id);
+
+CREATE INDEX IF NOT EXISTS ads_location_idx ON ads (latitude, longitude) WHERE latitude IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS ads_currency_idx ON ads (currency);
+
+CREATE INDEX IF NOT EXISTS ads_base_price_idx ON ads (base_price, id);
+
+CREATE INDEX IF NOT EXISTS ad_media_checks_pending_idx ON ad_media_checks (next_attempt_at) WHERE state = 'pending';
+
+CREATE INDEX IF NOT EXISTS ad_price_history_ad_id_idx ON ad_price_history (ad_id, changed_at);
+
+CREATE INDEX IF NOT EXISTS favorites_ad_id_idx ON favorites (ad_id);
+
+CREATE INDEX IF NOT EXISTS saved_searches_user_id_idx ON saved_searches (user_id);
+
+CREATE INDEX IF NOT EXISTS saved_searches_base_price_range_idx ON saved_searches USING GIST (base_price_range);
+
+CREATE INDEX IF NOT EXISTS saved_searches_category_id_idx ON saved_searches (category_id);
+
+CREATE INDEX IF NOT EXISTS saved_searches_tags_idx ON saved_searches USING GIN (tags);
+
+CREATE INDEX IF NOT EXISTS search_match_queue_queued_at_idx ON search_match_queue (queued_at);
+
+CREATE INDEX IF NOT EXISTS notifications_user_id_idx ON notifications (user_id, created_at);
+
+CREATE INDEX IF NOT EXISTS conversations_buyer_id_idx ON conversations (buyer_id);
+
+CREATE INDEX IF NOT EXISTS conversations_seller_id_idx ON conversations (seller_id);
+
+CREATE INDEX IF NOT EXISTS messages_conversation_id_idx ON messages (conversation_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS messages_unread_idx ON messages (conversation_id) WHERE read_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS ad_views_window_start_idx ON ad_views (window_start);
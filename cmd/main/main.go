@@ -2,17 +2,25 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/middleware/authCheck"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/middleware/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/fetcher"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/worker"
 	"github.com/gorilla/mux"
 	"github.com/jackc/pgx/v4/pgxpool"
 
@@ -23,6 +31,23 @@ import (
 	adHandler "github.com/K1tten2005/go_vk_intern/internal/pkg/ad/delivery/http"
 	adRepo "github.com/K1tten2005/go_vk_intern/internal/pkg/ad/repo"
 	adUsecase "github.com/K1tten2005/go_vk_intern/internal/pkg/ad/usecase"
+
+	categoryHandler "github.com/K1tten2005/go_vk_intern/internal/pkg/category/delivery/http"
+	categoryRepo "github.com/K1tten2005/go_vk_intern/internal/pkg/category/repo"
+	categoryUsecase "github.com/K1tten2005/go_vk_intern/internal/pkg/category/usecase"
+	chatHandler "github.com/K1tten2005/go_vk_intern/internal/pkg/chat/delivery/http"
+	chatRepo "github.com/K1tten2005/go_vk_intern/internal/pkg/chat/repo"
+	chatUsecase "github.com/K1tten2005/go_vk_intern/internal/pkg/chat/usecase"
+	currencyHandler "github.com/K1tten2005/go_vk_intern/internal/pkg/currency/delivery/http"
+	currencyRepo "github.com/K1tten2005/go_vk_intern/internal/pkg/currency/repo"
+	currencyUsecase "github.com/K1tten2005/go_vk_intern/internal/pkg/currency/usecase"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/events"
+	eventsHandler "github.com/K1tten2005/go_vk_intern/internal/pkg/events/delivery/http"
+
+	imageHandler "github.com/K1tten2005/go_vk_intern/internal/pkg/image/delivery/http"
+	imageRepo "github.com/K1tten2005/go_vk_intern/internal/pkg/image/repo"
+	imageStorage "github.com/K1tten2005/go_vk_intern/internal/pkg/image/storage"
+	imageUsecase "github.com/K1tten2005/go_vk_intern/internal/pkg/image/usecase"
 )
 
 func initDB(logger *slog.Logger) (*pgxpool.Pool, error) {
@@ -43,6 +68,81 @@ func initDB(logger *slog.Logger) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
+// durationFromEnv reads a time.Duration from the environment, falling back
+// to def if the variable is unset or malformed.
+func durationFromEnv(logger *slog.Logger, name string, def time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		logger.Error("invalid " + name + ", using default " + def.String())
+		return def
+	}
+	return d
+}
+
+// intFromEnv reads a positive int from the environment, falling back to def
+// if the variable is unset or malformed.
+func intFromEnv(logger *slog.Logger, name string, def int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		logger.Error("invalid " + name + ", using default " + strconv.Itoa(def))
+		return def
+	}
+	return n
+}
+
+// proxiesFromEnv reads a comma separated list of addresses and networks in
+// CIDR notation from the environment. Malformed entries are skipped.
+func proxiesFromEnv(logger *slog.Logger, name string) []*net.IPNet {
+	var proxies []*net.IPNet
+	for _, entry := range strings.Split(os.Getenv(name), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil && ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			logger.Error("invalid " + name + " entry " + entry + ", skipping it")
+			continue
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies
+}
+
+// viewerKeyFromEnv reads the key anonymous viewers are hashed with. Without
+// it a random key is used, so views are deduplicated only within one run of
+// one instance.
+func viewerKeyFromEnv(logger *slog.Logger, name string) []byte {
+	if value := os.Getenv(name); value != "" {
+		return []byte(value)
+	}
+
+	logger.Error(name + " is not set, using a random key")
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		logger.Error("error generating " + name + ": " + err.Error())
+	}
+	return key
+}
+
 func main() {
 	logPath := os.Getenv("MAIN_LOG_FILE")
 	logDir := "./logs"
@@ -76,9 +176,100 @@ func main() {
 	authUsecase := authUsecase.CreateAuthUsecase(authRepo)
 	authHandler := authHandler.CreateAuthHandler(authUsecase)
 
+	eventsHub := events.CreateHub(intFromEnv(loggerVar, "EVENT_STREAM_BUFFER", 64))
+	eventsHandler := eventsHandler.CreateEventsHandler(eventsHub)
+
+	adTTL := durationFromEnv(loggerVar, "AD_TTL", 30*24*time.Hour)
 	adRepo := adRepo.CreateAdRepo(pool)
-	adUsecase := adUsecase.CreateAdUsecase(adRepo)
-	adHandler := adHandler.CreateAdHandler(adUsecase)
+	// the fetcher is shared, so the same link is downloaded once for a
+	// while no matter how many ads use it
+	imageFetcher := fetcher.CreateFetcher(validation.MaxImageSizeBytes, validation.RemoteImageTypes)
+	adUsecase := adUsecase.CreateAdUsecase(adRepo, adTTL, eventsHub, imageFetcher)
+	adHandler := adHandler.CreateAdHandler(adUsecase, viewerKeyFromEnv(loggerVar, "VIEWER_HASH_KEY"), proxiesFromEnv(loggerVar, "TRUSTED_PROXIES"))
+
+	categoryRepo := categoryRepo.CreateCategoryRepo(pool)
+	categoryUsecase := categoryUsecase.CreateCategoryUsecase(categoryRepo)
+	categoryHandler := categoryHandler.CreateCategoryHandler(categoryUsecase)
+
+	currencyRepo := currencyRepo.CreateCurrencyRepo(pool)
+	currencyUsecase := currencyUsecase.CreateCurrencyUsecase(currencyRepo)
+	currencyHandler := currencyHandler.CreateCurrencyHandler(currencyUsecase)
+
+	chatRepo := chatRepo.CreateChatRepo(pool)
+	chatUsecase := chatUsecase.CreateChatUsecase(chatRepo, eventsHub)
+	chatHandler := chatHandler.CreateChatHandler(chatUsecase)
+
+	imageDir := os.Getenv("IMAGE_STORAGE_DIR")
+	if imageDir == "" {
+		imageDir = "./images"
+	}
+	imageStorage, err := imageStorage.CreateLocalStorage(imageDir)
+	if err != nil {
+		loggerVar.Error("Error while creating image storage: " + err.Error())
+		return
+	}
+	imageRepo := imageRepo.CreateImageRepo(pool)
+	imageUsecase := imageUsecase.CreateImageUsecase(imageRepo, imageStorage)
+	imageHandler := imageHandler.CreateImageHandler(imageUsecase)
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.RunPeriodic(workersCtx, loggerVar, "ad_archiver", time.Minute, func(ctx context.Context) error {
+			_, err := adUsecase.ArchiveExpiredAds(ctx)
+			return err
+		})
+	}()
+
+	retention := durationFromEnv(loggerVar, "AD_RETENTION_PERIOD", 30*24*time.Hour)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.RunPeriodic(workersCtx, loggerVar, "ad_retention", time.Hour, func(ctx context.Context) error {
+			_, err := adUsecase.PurgeDeletedAds(ctx, retention)
+			return err
+		})
+	}()
+
+	mediaWorkers := intFromEnv(loggerVar, "MEDIA_WORKERS", 4)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.RunTriggered(workersCtx, loggerVar, "media_verifier", 10*time.Second, adUsecase.MediaQueued(), func(ctx context.Context) error {
+			_, err := adUsecase.VerifyPendingMedia(ctx, mediaWorkers)
+			return err
+		})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.RunPeriodic(workersCtx, loggerVar, "search_matcher", 30*time.Second, func(ctx context.Context) error {
+			_, err := adUsecase.MatchSavedSearches(ctx)
+			return err
+		})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.RunTriggered(workersCtx, loggerVar, "ad_views", 5*time.Second, adUsecase.ViewsQueued(), func(ctx context.Context) error {
+			_, err := adUsecase.FlushAdViews(ctx)
+			return err
+		})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker.RunPeriodic(workersCtx, loggerVar, "ad_views_purge", time.Hour, func(ctx context.Context) error {
+			_, err := adUsecase.PurgeAdViews(ctx)
+			return err
+		})
+	}()
 
 	r := mux.NewRouter()
 	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -90,10 +281,51 @@ func main() {
 	publicRoutes.HandleFunc("/signin", authHandler.SignIn).Methods(http.MethodPost)
 	publicRoutes.HandleFunc("/signup", authHandler.SignUp).Methods(http.MethodPost)
 	publicRoutes.HandleFunc("/ad", adHandler.GetAds).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/ad/{id}", adHandler.GetAdById).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/ad/{id}/price-history", adHandler.GetPriceHistory).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/v2/ad", adHandler.GetAdsPage).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/users/{login}/ads", adHandler.GetUserAds).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/categories", categoryHandler.GetCategories).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/categories/{id}", categoryHandler.GetCategory).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/exchange-rates", currencyHandler.GetRates).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/tags/popular", adHandler.GetPopularTags).Methods(http.MethodGet)
+	publicRoutes.HandleFunc("/images/{id}", imageHandler.GetImage).Methods(http.MethodGet)
 
 	protectedRoutes := r.PathPrefix("/api").Subrouter()
 	protectedRoutes.Use(authCheck.AuthMiddleware(loggerVar))
 	protectedRoutes.HandleFunc("/ad", adHandler.CreateAd).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/ad/{id}", adHandler.UpdateAd).Methods(http.MethodPatch)
+	protectedRoutes.HandleFunc("/ad/{id}", adHandler.DeleteAd).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/ad/{id}/restore", adHandler.RestoreAd).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/ad/{id}/transition", adHandler.TransitionAd).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/ad/{id}/renew", adHandler.RenewAd).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/ad/{id}/verification", adHandler.GetAdVerification).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/ad/{id}/stats", adHandler.GetAdStats).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/ad/{id}/images", adHandler.AddAdImage).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/ad/{id}/images", adHandler.ReorderAdImages).Methods(http.MethodPut)
+	protectedRoutes.HandleFunc("/ad/{id}/images/{imageId}", adHandler.DeleteAdImage).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/ad/{id}/favorite", adHandler.AddFavorite).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/ad/{id}/favorite", adHandler.RemoveFavorite).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/me/ads", adHandler.GetMyAds).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/ad/{id}/messages", chatHandler.StartConversation).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/me/favorites", adHandler.GetMyFavorites).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/me/conversations", chatHandler.GetConversations).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/me/conversations/{id}/messages", chatHandler.GetMessages).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/me/conversations/{id}/messages", chatHandler.SendMessage).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/me/searches", adHandler.SaveSearch).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/me/searches", adHandler.GetSavedSearches).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/me/searches/{id}", adHandler.DeleteSavedSearch).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/me/notifications", adHandler.GetNotifications).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/me/events", eventsHandler.Stream).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/images", imageHandler.UploadImage).Methods(http.MethodPost)
+
+	adminRoutes := r.PathPrefix("/api").Subrouter()
+	adminRoutes.Use(authCheck.AuthMiddleware(loggerVar), authCheck.AdminMiddleware)
+	adminRoutes.HandleFunc("/categories", categoryHandler.CreateCategory).Methods(http.MethodPost)
+	adminRoutes.HandleFunc("/categories/{id}", categoryHandler.UpdateCategory).Methods(http.MethodPut)
+	adminRoutes.HandleFunc("/categories/{id}", categoryHandler.DeleteCategory).Methods(http.MethodDelete)
+	adminRoutes.HandleFunc("/exchange-rates/{currency}", currencyHandler.SetRate).Methods(http.MethodPut)
+	adminRoutes.HandleFunc("/exchange-rates/{currency}", currencyHandler.DeleteRate).Methods(http.MethodDelete)
 
 	srv := http.Server{
 		Handler:           r,
@@ -102,6 +334,9 @@ func main() {
 		WriteTimeout:      10 * time.Second,
 		ReadHeaderTimeout: 10 * time.Second,
 	}
+	// streams never end on their own, Shutdown would wait for them until
+	// its timeout
+	srv.RegisterOnShutdown(eventsHub.Close)
 
 	go func() {
 		if err := srv.ListenAndServe(); err != nil {
@@ -123,4 +358,12 @@ func main() {
 	} else {
 		loggerVar.Info("Server successfully stopped")
 	}
+
+	stopWorkers()
+	workers.Wait()
+	// views recorded by the last requests are still queued
+	if _, err := adUsecase.FlushAdViews(context.WithValue(ctx, logger.LoggerKey, loggerVar)); err != nil {
+		loggerVar.Error("Error while saving views: " + err.Error())
+	}
+	loggerVar.Info("Background workers stopped")
 }
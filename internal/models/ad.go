@@ -7,52 +7,352 @@ import (
 	"github.com/satori/uuid"
 )
 
+type AdStatus string
+
+const (
+	AdStatusDraft        AdStatus = "draft"
+	AdStatusPendingMedia AdStatus = "pending_media"
+	AdStatusPublished    AdStatus = "published"
+	AdStatusReserved     AdStatus = "reserved"
+	AdStatusSold         AdStatus = "sold"
+	AdStatusArchived     AdStatus = "archived"
+	AdStatusRejected     AdStatus = "rejected"
+)
+
 // easyjson:json
 type Ad struct {
 	Id          uuid.UUID
 	UserId      uuid.UUID
+	CategoryId  uuid.UUID
 	Title       string
 	Description string
-	Price       int
+	// Price is in minor units of Currency.
+	Price    int
+	Currency string
+	// BasePrice is Price converted to BaseCurrency at the current rate.
+	// Ads are sorted by it, so it is what keyset cursors hold.
+	BasePrice int
+	// DisplayPrice is Price converted to the currency of the filter, it is
+	// only set for ads selected by a filter.
+	DisplayPrice *int
+	// PreviousPrice is the price before the last change in Currency, it is
+	// only set when the price went down. DisplayPreviousPrice is the same
+	// price in the currency of the filter.
+	PreviousPrice        *int
+	DisplayPreviousPrice *int
+	// ImageURL is the URL of the cover image of the gallery.
 	ImageURL    string
+	Images      AdImageList
 	CreatedAt   time.Time
 	AuthorLogin string
+	Version     int
+	Status      AdStatus
+	// PendingStatus is the status a new ad gets once its remote images are
+	// checked, it is only set while Status is pending_media.
+	PendingStatus AdStatus
+	ExpiresAt     time.Time
+	Attributes    map[string]interface{}
+	Tags          []string
+	City          string
+	// Latitude and Longitude are either both set or both nil.
+	Latitude  *float64
+	Longitude *float64
+	// DistanceKm is the distance to the point of Filter.Location, it is
+	// only set when ads are selected with a location.
+	DistanceKm *float64
+	// FavoritesCount is how many users saved the ad. IsFavorite tells
+	// whether the user asking for the ad is one of them.
+	FavoritesCount int
+	IsFavorite     bool
 }
 
 // easyjson:json
 type AdReq struct {
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	ImageURL    string    `json:"image_url"`
-	Price       float64   `json:"price"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	ImageURL    string                 `json:"image_url"`
+	Images      []string               `json:"images,omitempty"`
+	ImageIds    []uuid.UUID            `json:"image_ids,omitempty"`
+	Price       Money                  `json:"price"`
+	Currency    string                 `json:"currency,omitempty"`
+	CategoryId  uuid.UUID              `json:"category_id"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	City        string                 `json:"city,omitempty"`
+	Latitude    *float64               `json:"latitude,omitempty"`
+	Longitude   *float64               `json:"longitude,omitempty"`
+	Status      AdStatus               `json:"status,omitempty"`
 }
 
 // easyjson:json
 type AdResp struct {
-	Id          uuid.UUID `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Price       float64   `json:"price"`
-	ImageURL    string    `json:"image_url"`
-	CreatedAt   time.Time `json:"created_at"`
-	AuthorLogin string    `json:"author_login"`
-	IsOwner     bool      `json:"is_owner,omitempty"`
+	Id          uuid.UUID              `json:"id"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	Price       Money                  `json:"price"`
+	Currency    string                 `json:"currency"`
+	CategoryId  uuid.UUID              `json:"category_id"`
+	ImageURL    string                 `json:"image_url"`
+	Images      AdImageList            `json:"images"`
+	CreatedAt   time.Time              `json:"created_at"`
+	AuthorLogin string                 `json:"author_login"`
+	Version     int                    `json:"version"`
+	Status      AdStatus               `json:"status"`
+	ExpiresAt   time.Time              `json:"expires_at"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	City        string                 `json:"city,omitempty"`
+	Latitude    *float64               `json:"latitude,omitempty"`
+	Longitude   *float64               `json:"longitude,omitempty"`
+	DistanceKm  *float64               `json:"distance_km,omitempty"`
+	IsOwner     bool                   `json:"is_owner,omitempty"`
+	// OriginalPrice and OriginalCurrency are set when Price is converted
+	// to the currency asked by the client.
+	OriginalPrice    *Money `json:"original_price,omitempty"`
+	OriginalCurrency string `json:"original_currency,omitempty"`
+	// PreviousPrice is the price before the last change, in the same
+	// currency as Price. It is only set when the price went down.
+	PriceDropped  bool   `json:"price_dropped,omitempty"`
+	PreviousPrice *Money `json:"previous_price,omitempty"`
+	// IsFavorite is only reported to authorized users.
+	FavoritesCount int  `json:"favorites_count"`
+	IsFavorite     bool `json:"is_favorite,omitempty"`
+}
+
+// easyjson:json
+type AdPatchReq struct {
+	Title       *string                `json:"title"`
+	Description *string                `json:"description"`
+	ImageURL    *string                `json:"image_url"`
+	Price       *Money                 `json:"price"`
+	Currency    *string                `json:"currency"`
+	CategoryId  *uuid.UUID             `json:"category_id"`
+	Attributes  map[string]interface{} `json:"attributes"`
+	Tags        []string               `json:"tags"`
+	City        *string                `json:"city"`
+	Latitude    *float64               `json:"latitude"`
+	Longitude   *float64               `json:"longitude"`
+}
+
+// AdPatch holds the fields of a partial update, nil fields are left as is.
+// Price is in minor units like in Ad.
+type AdPatch struct {
+	Title       *string
+	Description *string
+	ImageURL    *string
+	Price       *int
+	Currency    *string
+	CategoryId  *uuid.UUID
+	// Attributes and Tags replace all values of the ad when not nil.
+	Attributes map[string]interface{}
+	Tags       []string
+	City       *string
+	Latitude   *float64
+	Longitude  *float64
+}
+
+// AdImage is one photo of the gallery of an ad. Positions start at 0 and
+// have no gaps, exactly one image of a non empty gallery is the cover.
+//
+// easyjson:json
+type AdImage struct {
+	Id  uuid.UUID `json:"id"`
+	URL string    `json:"url"`
+	// ImageId is set for images uploaded to POST /api/images, only they
+	// have thumbnails.
+	ImageId    *uuid.UUID        `json:"image_id,omitempty"`
+	Thumbnails map[string]string `json:"thumbnails,omitempty"`
+	Position   int               `json:"position"`
+	IsCover    bool              `json:"is_cover"`
+}
+
+//easyjson:json
+type AdImageList []AdImage
+
+// Cover returns the cover image, ok is false for an empty gallery.
+func (l AdImageList) Cover() (AdImage, bool) {
+	for _, image := range l {
+		if image.IsCover {
+			return image, true
+		}
+	}
+	return AdImage{}, false
+}
+
+// easyjson:json
+type AdImageReq struct {
+	URL     string     `json:"url"`
+	ImageId *uuid.UUID `json:"image_id,omitempty"`
+	IsCover bool       `json:"is_cover,omitempty"`
+}
+
+// AdImageOrderReq lists every image of the gallery in the new order. The
+// cover stays the same when CoverId is not set.
+//
+// easyjson:json
+type AdImageOrderReq struct {
+	ImageIds []uuid.UUID `json:"image_ids"`
+	CoverId  *uuid.UUID  `json:"cover_id"`
+}
+
+// easyjson:json
+type AdTransitionReq struct {
+	Status AdStatus `json:"status"`
 }
 
 //easyjson:json
 type AdRespList []AdResp
 
+// Cursor points at the last ad of a page for keyset pagination. Only the
+// key matching SortBy is set, Price is the price in BaseCurrency. Currency
+// is the currency of the filter the cursor was made for.
+//
+// easyjson:json
+type Cursor struct {
+	SortBy    string    `json:"s"`
+	Order     string    `json:"o"`
+	Price     int       `json:"p,omitempty"`
+	CreatedAt time.Time `json:"c,omitempty"`
+	Currency  string    `json:"cu"`
+	Id        uuid.UUID `json:"i"`
+}
+
 type Filter struct {
-	Page     int
-	Limit    int
-	SortBy   string
-	Order    string
+	Page   int
+	Limit  int
+	SortBy string
+	Order  string
+	// PriceMin and PriceMax are in minor units of Currency, or of
+	// BaseCurrency when it is empty. PriceMax 0 means no upper bound.
 	PriceMin int
 	PriceMax int
+	// Currency is the currency prices are converted to in the response.
+	Currency string
 	UserId   uuid.UUID
+	Query    string
+	Cursor   *Cursor
+	// AuthorId and AuthorLogin limit the result to ads of one seller.
+	AuthorId    uuid.UUID
+	AuthorLogin string
+	// Statuses lists the statuses to return. Empty means the public
+	// listing: published ads which have not expired yet.
+	Statuses []AdStatus
+	// CategoryId limits the result to the category and all its descendants.
+	CategoryId uuid.UUID
+	Attributes []AttributeFilter
+	// Tags keeps ads having any of the tags, or all of them with TagsAll.
+	Tags    []string
+	TagsAll bool
+	// Location is the point distances are measured from. With RadiusKm
+	// only ads within that distance are returned.
+	Location *GeoPoint
+	RadiusKm float64
+	// FavoritesOf limits the result to ads saved by the user.
+	FavoritesOf uuid.UUID
+}
+
+type GeoPoint struct {
+	Lat float64
+	Lon float64
+}
+
+// AttributeFilter is one attr.<name> parameter of GetAds. Op is "eq" for
+// attr.<name>, "min" and "max" for attr.<name>_min and attr.<name>_max.
+type AttributeFilter struct {
+	Name  string
+	Op    string
+	Value string
+}
+
+// AdList is a page of ads. HasNext and NextCursor describe the rest of the
+// result set.
+type AdList struct {
+	Ads        []Ad
+	HasNext    bool
+	NextCursor string
+	Total      int
+}
+
+// easyjson:json
+type FilterResp struct {
+	SortBy   string     `json:"sort_by"`
+	Order    string     `json:"order"`
+	PriceMin Money      `json:"price_min"`
+	PriceMax Money      `json:"price_max"`
+	Currency string     `json:"currency,omitempty"`
+	Query    string     `json:"q,omitempty"`
+	Author   string     `json:"author,omitempty"`
+	Statuses []AdStatus `json:"statuses,omitempty"`
+	Category string     `json:"category,omitempty"`
+	// Attributes is keyed by the parameter name without the attr. prefix.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
+	TagsMode   string            `json:"tags_mode,omitempty"`
+	Lat        *float64          `json:"lat,omitempty"`
+	Lon        *float64          `json:"lon,omitempty"`
+	RadiusKm   float64           `json:"radius_km,omitempty"`
+}
+
+// PriceChange is one entry of the price history of an ad, Price is the one
+// set at ChangedAt.
+type PriceChange struct {
+	Price     int
+	Currency  string
+	ChangedAt time.Time
+}
+
+// easyjson:json
+type PriceChangeResp struct {
+	Price     Money     `json:"price"`
+	Currency  string    `json:"currency"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+//easyjson:json
+type PriceChangeRespList []PriceChangeResp
+
+// easyjson:json
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+//easyjson:json
+type TagCountList []TagCount
+
+// easyjson:json
+type AdPageResp struct {
+	Items      AdRespList `json:"items"`
+	Total      int        `json:"total"`
+	Page       int        `json:"page"`
+	Limit      int        `json:"limit"`
+	HasNext    bool       `json:"has_next"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+	Filter     FilterResp `json:"filter"`
 }
 
 func (a *Ad) Sanitize() {
 	a.Title = html.EscapeString(a.Title)
 	a.Description = html.EscapeString(a.Description)
 }
+
+// MediaCheck is a background check of the remote images of a new ad.
+type MediaCheck struct {
+	AdId         uuid.UUID
+	TargetStatus AdStatus
+	Rejected     bool
+	Reason       string
+	Attempts     int
+	CheckedAt    *time.Time
+}
+
+// AdVerification is what the owner of an ad sees about the check of its
+// images. Reason is set for rejected ads and for checks to be retried.
+//
+// easyjson:json
+type AdVerification struct {
+	Status    AdStatus   `json:"status"`
+	Reason    string     `json:"reason,omitempty"`
+	Attempts  int        `json:"attempts"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"html"
+
+	"github.com/satori/uuid"
+)
+
+type AttributeType string
+
+const (
+	AttributeInt    AttributeType = "int"
+	AttributeFloat  AttributeType = "float"
+	AttributeString AttributeType = "string"
+	AttributeBool   AttributeType = "bool"
+	AttributeEnum   AttributeType = "enum"
+)
+
+// AttributeSchema describes one structured attribute of ads in a category.
+// Min and Max bound numeric attributes, Values lists the options of an enum.
+//
+// easyjson:json
+type AttributeSchema struct {
+	Name     string        `json:"name"`
+	Type     AttributeType `json:"type"`
+	Required bool          `json:"required,omitempty"`
+	Min      *float64      `json:"min,omitempty"`
+	Max      *float64      `json:"max,omitempty"`
+	Values   []string      `json:"values,omitempty"`
+}
+
+//easyjson:json
+type AttributeSchemaList []AttributeSchema
+
+// Extend returns the schema of a subcategory: attributes of l with the ones
+// in own added, an attribute in own replaces the inherited one of the same
+// name.
+func (l AttributeSchemaList) Extend(own AttributeSchemaList) AttributeSchemaList {
+	result := make(AttributeSchemaList, 0, len(l)+len(own))
+	for _, attr := range l {
+		if !own.Has(attr.Name) {
+			result = append(result, attr)
+		}
+	}
+	return append(result, own...)
+}
+
+func (l AttributeSchemaList) Has(name string) bool {
+	for _, attr := range l {
+		if attr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Category is a node of the category tree. ParentId is uuid.Nil for root
+// categories. Attributes holds only the attributes defined on the category
+// itself, subcategories inherit the ones of their ancestors.
+//
+// easyjson:json
+type Category struct {
+	Id         uuid.UUID
+	ParentId   uuid.UUID
+	Name       string
+	Attributes AttributeSchemaList
+}
+
+// easyjson:json
+type CategoryReq struct {
+	Name       string              `json:"name"`
+	ParentId   *uuid.UUID          `json:"parent_id,omitempty"`
+	Attributes AttributeSchemaList `json:"attributes,omitempty"`
+}
+
+// easyjson:json
+type CategoryResp struct {
+	Id         uuid.UUID           `json:"id"`
+	Name       string              `json:"name"`
+	ParentId   *uuid.UUID          `json:"parent_id,omitempty"`
+	Attributes AttributeSchemaList `json:"attributes,omitempty"`
+	Children   CategoryRespList    `json:"children,omitempty"`
+}
+
+//easyjson:json
+type CategoryRespList []CategoryResp
+
+func (c *Category) Sanitize() {
+	c.Name = html.EscapeString(c.Name)
+}
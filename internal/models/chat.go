@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// Conversation is a thread between a buyer and the seller of an ad. It is
+// read-only once the ad is archived or deleted. PeerLogin and Unread are
+// seen from the side of the user the conversation was selected for.
+type Conversation struct {
+	Id          uuid.UUID
+	AdId        uuid.UUID
+	AdTitle     string
+	BuyerId     uuid.UUID
+	SellerId    uuid.UUID
+	PeerLogin   string
+	ReadOnly    bool
+	Unread      int
+	LastMessage *Message
+	CreatedAt   time.Time
+}
+
+// Message is one message of a conversation. ReadAt is set once the other
+// side fetched it.
+type Message struct {
+	Id             uuid.UUID
+	ConversationId uuid.UUID
+	SenderId       uuid.UUID
+	Text           string
+	CreatedAt      time.Time
+	ReadAt         *time.Time
+}
+
+// MessageList is a page of a message history, the newest messages first.
+// HasNext tells whether older messages are left.
+type MessageList struct {
+	Messages []Message
+	HasNext  bool
+}
+
+// easyjson:json
+type MessageReq struct {
+	Text string `json:"text"`
+}
+
+// easyjson:json
+type MessageResp struct {
+	Id             uuid.UUID  `json:"id"`
+	ConversationId uuid.UUID  `json:"conversation_id"`
+	IsMine         bool       `json:"is_mine"`
+	Text           string     `json:"text"`
+	CreatedAt      time.Time  `json:"created_at"`
+	ReadAt         *time.Time `json:"read_at,omitempty"`
+}
+
+//easyjson:json
+type MessageRespList []MessageResp
+
+// ConversationResp describes a conversation for one of its sides, Role is
+// either "buyer" or "seller".
+//
+// easyjson:json
+type ConversationResp struct {
+	Id          uuid.UUID    `json:"id"`
+	AdId        uuid.UUID    `json:"ad_id"`
+	AdTitle     string       `json:"ad_title"`
+	Role        string       `json:"role"`
+	PeerLogin   string       `json:"peer_login"`
+	ReadOnly    bool         `json:"read_only"`
+	UnreadCount int          `json:"unread_count"`
+	LastMessage *MessageResp `json:"last_message,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+//easyjson:json
+type ConversationRespList []ConversationResp
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// BaseCurrency is the currency every exchange rate is quoted in. Ads
+// created without a currency are priced in it.
+const BaseCurrency = "RUB"
+
+// ExchangeRate is the price of one unit of Currency in BaseCurrency. Rate is
+// kept as a decimal string, conversions are done by the database in numeric
+// so no rounding happens on the way.
+//
+// easyjson:json
+type ExchangeRate struct {
+	Currency  string      `json:"currency"`
+	Rate      json.Number `json:"rate"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+//easyjson:json
+type ExchangeRateList []ExchangeRate
+
+// easyjson:json
+type ExchangeRateReq struct {
+	Rate json.Number `json:"rate"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import "github.com/satori/uuid"
+
+type EventType string
+
+const (
+	EventMessage      EventType = "message"
+	EventPriceChanged EventType = "price_changed"
+	EventSearchMatch  EventType = "search_match"
+	EventAdModerated  EventType = "ad_moderated"
+)
+
+// Event is pushed to the open event streams of a user. Data is sent as
+// JSON: MessageResp for a message, NotificationResp for a saved search
+// match and the types below for the other events.
+type Event struct {
+	Type EventType
+	Data interface{}
+}
+
+// PriceChangedEvent tells a user that the price of an ad in their
+// favorites changed. PreviousPrice is only set when the price went down,
+// as in AdResp.
+//
+// easyjson:json
+type PriceChangedEvent struct {
+	AdId          uuid.UUID `json:"ad_id"`
+	Title         string    `json:"title"`
+	Price         Money     `json:"price"`
+	Currency      string    `json:"currency"`
+	PriceDropped  bool      `json:"price_dropped,omitempty"`
+	PreviousPrice *Money    `json:"previous_price,omitempty"`
+}
+
+// AdModeratedEvent tells the owner of an ad created with remote images how
+// their check ended. Reason is only set for rejected ads.
+//
+// easyjson:json
+type AdModeratedEvent struct {
+	AdId   uuid.UUID `json:"ad_id"`
+	Status AdStatus  `json:"status"`
+	Reason string    `json:"reason,omitempty"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// Image is a picture uploaded by a user. The original and its thumbnails
+// are kept in the image storage, the database only holds the metadata.
+type Image struct {
+	Id          uuid.UUID
+	UserId      uuid.UUID
+	ContentType string
+	Width       int
+	Height      int
+	Size        int
+	CreatedAt   time.Time
+}
+
+// easyjson:json
+type ImageResp struct {
+	Id          uuid.UUID         `json:"id"`
+	URL         string            `json:"url"`
+	Thumbnails  map[string]string `json:"thumbnails"`
+	ContentType string            `json:"content_type"`
+	Width       int               `json:"width"`
+	Height      int               `json:"height"`
+	Size        int               `json:"size"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
@@ -7,6 +7,8 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	uuid "github.com/satori/uuid"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +19,82 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels(in *jlexer.Lexer, out *UserResp) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels(in *jlexer.Lexer, out *Viewer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "Key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels(out *jwriter.Writer, in Viewer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UserId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.UserId).MarshalText())
+	}
+	{
+		const prefix string = ",\"Key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Viewer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Viewer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Viewer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Viewer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels1(in *jlexer.Lexer, out *UserResp) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -54,7 +131,7 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels(in *jlexe
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels(out *jwriter.Writer, in UserResp) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels1(out *jwriter.Writer, in UserResp) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -79,27 +156,27 @@ func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels(out *jwri
 // MarshalJSON supports json.Marshaler interface
 func (v UserResp) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserResp) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels(w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserResp) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels(l, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels1(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels1(in *jlexer.Lexer, out *UserReq) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels2(in *jlexer.Lexer, out *UserReq) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -132,7 +209,7 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels1(in *jlex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels1(out *jwriter.Writer, in UserReq) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels2(out *jwriter.Writer, in UserReq) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -152,27 +229,27 @@ func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels1(out *jwr
 // MarshalJSON supports json.Marshaler interface
 func (v UserReq) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels1(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserReq) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels1(w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserReq) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels1(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels1(l, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels2(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels2(in *jlexer.Lexer, out *User) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(in *jlexer.Lexer, out *User) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -207,7 +284,7 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels2(in *jlex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels2(out *jwriter.Writer, in User) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels3(out *jwriter.Writer, in User) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -227,27 +304,93 @@ func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels2(out *jwr
 // MarshalJSON supports json.Marshaler interface
 func (v User) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels2(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v User) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels2(w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *User) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels2(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels2(l, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(in *jlexer.Lexer, out *TagCountList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TagCountList, 0, 2)
+			} else {
+				*out = TagCountList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 TagCount
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels4(out *jwriter.Writer, in TagCountList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TagCountList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TagCountList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TagCountList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TagCountList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(in *jlexer.Lexer, out *Filter) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(in *jlexer.Lexer, out *TagCount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -266,22 +409,10 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(in *jlex
 			continue
 		}
 		switch key {
-		case "Page":
-			out.Page = int(in.Int())
-		case "Limit":
-			out.Limit = int(in.Int())
-		case "SortBy":
-			out.SortBy = string(in.String())
-		case "Order":
-			out.Order = string(in.String())
-		case "PriceMin":
-			out.PriceMin = int(in.Int())
-		case "PriceMax":
-			out.PriceMax = int(in.Int())
-		case "UserId":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.UserId).UnmarshalText(data))
-			}
+		case "tag":
+			out.Tag = string(in.String())
+		case "count":
+			out.Count = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -292,72 +423,47 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(in *jlex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels3(out *jwriter.Writer, in Filter) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels5(out *jwriter.Writer, in TagCount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Page\":"
+		const prefix string = ",\"tag\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Page))
-	}
-	{
-		const prefix string = ",\"Limit\":"
-		out.RawString(prefix)
-		out.Int(int(in.Limit))
-	}
-	{
-		const prefix string = ",\"SortBy\":"
-		out.RawString(prefix)
-		out.String(string(in.SortBy))
-	}
-	{
-		const prefix string = ",\"Order\":"
-		out.RawString(prefix)
-		out.String(string(in.Order))
-	}
-	{
-		const prefix string = ",\"PriceMin\":"
-		out.RawString(prefix)
-		out.Int(int(in.PriceMin))
+		out.String(string(in.Tag))
 	}
 	{
-		const prefix string = ",\"PriceMax\":"
-		out.RawString(prefix)
-		out.Int(int(in.PriceMax))
-	}
-	{
-		const prefix string = ",\"UserId\":"
+		const prefix string = ",\"count\":"
 		out.RawString(prefix)
-		out.RawText((in.UserId).MarshalText())
+		out.Int(int(in.Count))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Filter) MarshalJSON() ([]byte, error) {
+func (v TagCount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels3(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Filter) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels3(w, v)
+func (v TagCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Filter) UnmarshalJSON(data []byte) error {
+func (v *TagCount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Filter) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels3(l, v)
+func (v *TagCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(in *jlexer.Lexer, out *AdRespList) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(in *jlexer.Lexer, out *SavedSearchRespList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -366,17 +472,17 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(in *jlex
 		in.Delim('[')
 		if *out == nil {
 			if !in.IsDelim(']') {
-				*out = make(AdRespList, 0, 0)
+				*out = make(SavedSearchRespList, 0, 0)
 			} else {
-				*out = AdRespList{}
+				*out = SavedSearchRespList{}
 			}
 		} else {
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v1 AdResp
-			(v1).UnmarshalEasyJSON(in)
-			*out = append(*out, v1)
+			var v4 SavedSearchResp
+			(v4).UnmarshalEasyJSON(in)
+			*out = append(*out, v4)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -385,45 +491,45 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(in *jlex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels4(out *jwriter.Writer, in AdRespList) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels6(out *jwriter.Writer, in SavedSearchRespList) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v2, v3 := range in {
-			if v2 > 0 {
+		for v5, v6 := range in {
+			if v5 > 0 {
 				out.RawByte(',')
 			}
-			(v3).MarshalEasyJSON(out)
+			(v6).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AdRespList) MarshalJSON() ([]byte, error) {
+func (v SavedSearchRespList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels4(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AdRespList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels4(w, v)
+func (v SavedSearchRespList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AdRespList) UnmarshalJSON(data []byte) error {
+func (v *SavedSearchRespList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AdRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels4(l, v)
+func (v *SavedSearchRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(in *jlexer.Lexer, out *AdResp) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(in *jlexer.Lexer, out *SavedSearchResp) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -446,22 +552,16 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(in *jlex
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.Id).UnmarshalText(data))
 			}
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "price":
-			out.Price = float64(in.Float64())
-		case "image_url":
-			out.ImageURL = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "query":
+			out.Query = string(in.String())
+		case "filter":
+			(out.Filter).UnmarshalEasyJSON(in)
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
-		case "author_login":
-			out.AuthorLogin = string(in.String())
-		case "is_owner":
-			out.IsOwner = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -472,7 +572,7 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(in *jlex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels5(out *jwriter.Writer, in AdResp) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(out *jwriter.Writer, in SavedSearchResp) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -482,67 +582,6428 @@ func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels5(out *jwr
 		out.RawText((in.Id).MarshalText())
 	}
 	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"price\":"
+		const prefix string = ",\"query\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		out.String(string(in.Query))
 	}
 	{
-		const prefix string = ",\"image_url\":"
+		const prefix string = ",\"filter\":"
 		out.RawString(prefix)
-		out.String(string(in.ImageURL))
+		(in.Filter).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
 		out.Raw((in.CreatedAt).MarshalJSON())
 	}
-	{
-		const prefix string = ",\"author_login\":"
-		out.RawString(prefix)
-		out.String(string(in.AuthorLogin))
-	}
-	if in.IsOwner {
-		const prefix string = ",\"is_owner\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsOwner))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AdResp) MarshalJSON() ([]byte, error) {
+func (v SavedSearchResp) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels5(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearchResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearchResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearchResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels8(in *jlexer.Lexer, out *SavedSearchReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "query":
+			out.Query = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels8(out *jwriter.Writer, in SavedSearchReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedSearchReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearchReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearchReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearchReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels9(in *jlexer.Lexer, out *SavedSearch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "UserId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "Name":
+			out.Name = string(in.String())
+		case "Query":
+			out.Query = string(in.String())
+		case "Filter":
+			(out.Filter).UnmarshalEasyJSON(in)
+		case "CreatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels9(out *jwriter.Writer, in SavedSearch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"UserId\":"
+		out.RawString(prefix)
+		out.RawText((in.UserId).MarshalText())
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"Filter\":"
+		out.RawString(prefix)
+		(in.Filter).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"CreatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels10(in *jlexer.Lexer, out *PriceChangedEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ad_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "currency":
+			out.Currency = string(in.String())
+		case "price_dropped":
+			out.PriceDropped = bool(in.Bool())
+		case "previous_price":
+			if in.IsNull() {
+				in.Skip()
+				out.PreviousPrice = nil
+			} else {
+				if out.PreviousPrice == nil {
+					out.PreviousPrice = new(Money)
+				}
+				(*out.PreviousPrice).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels10(out *jwriter.Writer, in PriceChangedEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ad_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		(in.Price).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	if in.PriceDropped {
+		const prefix string = ",\"price_dropped\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceDropped))
+	}
+	if in.PreviousPrice != nil {
+		const prefix string = ",\"previous_price\":"
+		out.RawString(prefix)
+		(*in.PreviousPrice).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceChangedEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceChangedEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceChangedEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceChangedEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels11(in *jlexer.Lexer, out *PriceChangeRespList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(PriceChangeRespList, 0, 1)
+			} else {
+				*out = PriceChangeRespList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v7 PriceChangeResp
+			(v7).UnmarshalEasyJSON(in)
+			*out = append(*out, v7)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels11(out *jwriter.Writer, in PriceChangeRespList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v8, v9 := range in {
+			if v8 > 0 {
+				out.RawByte(',')
+			}
+			(v9).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceChangeRespList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceChangeRespList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceChangeRespList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceChangeRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels11(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels12(in *jlexer.Lexer, out *PriceChangeResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "currency":
+			out.Currency = string(in.String())
+		case "changed_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ChangedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels12(out *jwriter.Writer, in PriceChangeResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix[1:])
+		(in.Price).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"changed_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ChangedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceChangeResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceChangeResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceChangeResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceChangeResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels12(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels13(in *jlexer.Lexer, out *PriceChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Price":
+			out.Price = int(in.Int())
+		case "Currency":
+			out.Currency = string(in.String())
+		case "ChangedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ChangedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels13(out *jwriter.Writer, in PriceChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Price\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Price))
+	}
+	{
+		const prefix string = ",\"Currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"ChangedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ChangedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels13(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels14(in *jlexer.Lexer, out *NotificationRespList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(NotificationRespList, 0, 0)
+			} else {
+				*out = NotificationRespList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v10 NotificationResp
+			(v10).UnmarshalEasyJSON(in)
+			*out = append(*out, v10)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels14(out *jwriter.Writer, in NotificationRespList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v11, v12 := range in {
+			if v11 > 0 {
+				out.RawByte(',')
+			}
+			(v12).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationRespList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationRespList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationRespList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels14(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels15(in *jlexer.Lexer, out *NotificationResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "search_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SearchId).UnmarshalText(data))
+			}
+		case "search_name":
+			out.SearchName = string(in.String())
+		case "ad_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "ad_title":
+			out.AdTitle = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels15(out *jwriter.Writer, in NotificationResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"search_id\":"
+		out.RawString(prefix)
+		out.RawText((in.SearchId).MarshalText())
+	}
+	{
+		const prefix string = ",\"search_name\":"
+		out.RawString(prefix)
+		out.String(string(in.SearchName))
+	}
+	{
+		const prefix string = ",\"ad_id\":"
+		out.RawString(prefix)
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"ad_title\":"
+		out.RawString(prefix)
+		out.String(string(in.AdTitle))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels15(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels16(in *jlexer.Lexer, out *Notification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "UserId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "SearchId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SearchId).UnmarshalText(data))
+			}
+		case "SearchName":
+			out.SearchName = string(in.String())
+		case "AdId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "AdTitle":
+			out.AdTitle = string(in.String())
+		case "CreatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels16(out *jwriter.Writer, in Notification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"UserId\":"
+		out.RawString(prefix)
+		out.RawText((in.UserId).MarshalText())
+	}
+	{
+		const prefix string = ",\"SearchId\":"
+		out.RawString(prefix)
+		out.RawText((in.SearchId).MarshalText())
+	}
+	{
+		const prefix string = ",\"SearchName\":"
+		out.RawString(prefix)
+		out.String(string(in.SearchName))
+	}
+	{
+		const prefix string = ",\"AdId\":"
+		out.RawString(prefix)
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"AdTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.AdTitle))
+	}
+	{
+		const prefix string = ",\"CreatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels16(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels17(in *jlexer.Lexer, out *MessageRespList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(MessageRespList, 0, 0)
+			} else {
+				*out = MessageRespList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v13 MessageResp
+			(v13).UnmarshalEasyJSON(in)
+			*out = append(*out, v13)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels17(out *jwriter.Writer, in MessageRespList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v14, v15 := range in {
+			if v14 > 0 {
+				out.RawByte(',')
+			}
+			(v15).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageRespList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageRespList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageRespList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels17(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels18(in *jlexer.Lexer, out *MessageResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "conversation_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ConversationId).UnmarshalText(data))
+			}
+		case "is_mine":
+			out.IsMine = bool(in.Bool())
+		case "text":
+			out.Text = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "read_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReadAt = nil
+			} else {
+				if out.ReadAt == nil {
+					out.ReadAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReadAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels18(out *jwriter.Writer, in MessageResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"conversation_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ConversationId).MarshalText())
+	}
+	{
+		const prefix string = ",\"is_mine\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMine))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if in.ReadAt != nil {
+		const prefix string = ",\"read_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReadAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels18(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels19(in *jlexer.Lexer, out *MessageReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels19(out *jwriter.Writer, in MessageReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels19(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels20(in *jlexer.Lexer, out *MessageList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]Message, 0, 0)
+					} else {
+						out.Messages = []Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 Message
+					(v16).UnmarshalEasyJSON(in)
+					out.Messages = append(out.Messages, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "HasNext":
+			out.HasNext = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels20(out *jwriter.Writer, in MessageList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Messages {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"HasNext\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasNext))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels21(in *jlexer.Lexer, out *Message) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "ConversationId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ConversationId).UnmarshalText(data))
+			}
+		case "SenderId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SenderId).UnmarshalText(data))
+			}
+		case "Text":
+			out.Text = string(in.String())
+		case "CreatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "ReadAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ReadAt = nil
+			} else {
+				if out.ReadAt == nil {
+					out.ReadAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReadAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels21(out *jwriter.Writer, in Message) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"ConversationId\":"
+		out.RawString(prefix)
+		out.RawText((in.ConversationId).MarshalText())
+	}
+	{
+		const prefix string = ",\"SenderId\":"
+		out.RawString(prefix)
+		out.RawText((in.SenderId).MarshalText())
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"CreatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"ReadAt\":"
+		out.RawString(prefix)
+		if in.ReadAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ReadAt).MarshalJSON())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Message) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Message) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels22(in *jlexer.Lexer, out *MediaCheck) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AdId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "TargetStatus":
+			out.TargetStatus = AdStatus(in.String())
+		case "Rejected":
+			out.Rejected = bool(in.Bool())
+		case "Reason":
+			out.Reason = string(in.String())
+		case "Attempts":
+			out.Attempts = int(in.Int())
+		case "CheckedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.CheckedAt = nil
+			} else {
+				if out.CheckedAt == nil {
+					out.CheckedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CheckedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels22(out *jwriter.Writer, in MediaCheck) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AdId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"TargetStatus\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetStatus))
+	}
+	{
+		const prefix string = ",\"Rejected\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Rejected))
+	}
+	{
+		const prefix string = ",\"Reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"Attempts\":"
+		out.RawString(prefix)
+		out.Int(int(in.Attempts))
+	}
+	{
+		const prefix string = ",\"CheckedAt\":"
+		out.RawString(prefix)
+		if in.CheckedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CheckedAt).MarshalJSON())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MediaCheck) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MediaCheck) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MediaCheck) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MediaCheck) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels23(in *jlexer.Lexer, out *ImageResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "url":
+			out.URL = string(in.String())
+		case "thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Thumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v19 string
+					v19 = string(in.String())
+					(out.Thumbnails)[key] = v19
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "content_type":
+			out.ContentType = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "size":
+			out.Size = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels23(out *jwriter.Writer, in ImageResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"thumbnails\":"
+		out.RawString(prefix)
+		if in.Thumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v20First := true
+			for v20Name, v20Value := range in.Thumbnails {
+				if v20First {
+					v20First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v20Name))
+				out.RawByte(':')
+				out.String(string(v20Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"content_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels23(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels24(in *jlexer.Lexer, out *Image) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "UserId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "ContentType":
+			out.ContentType = string(in.String())
+		case "Width":
+			out.Width = int(in.Int())
+		case "Height":
+			out.Height = int(in.Int())
+		case "Size":
+			out.Size = int(in.Int())
+		case "CreatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels24(out *jwriter.Writer, in Image) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"UserId\":"
+		out.RawString(prefix)
+		out.RawText((in.UserId).MarshalText())
+	}
+	{
+		const prefix string = ",\"ContentType\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
+	{
+		const prefix string = ",\"Width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"Height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"Size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"CreatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Image) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Image) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Image) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Image) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels24(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels25(in *jlexer.Lexer, out *GeoPoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Lat":
+			out.Lat = float64(in.Float64())
+		case "Lon":
+			out.Lon = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels25(out *jwriter.Writer, in GeoPoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Lat\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Lat))
+	}
+	{
+		const prefix string = ",\"Lon\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Lon))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GeoPoint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GeoPoint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GeoPoint) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GeoPoint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels25(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels26(in *jlexer.Lexer, out *FilterResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sort_by":
+			out.SortBy = string(in.String())
+		case "order":
+			out.Order = string(in.String())
+		case "price_min":
+			(out.PriceMin).UnmarshalEasyJSON(in)
+		case "price_max":
+			(out.PriceMax).UnmarshalEasyJSON(in)
+		case "currency":
+			out.Currency = string(in.String())
+		case "q":
+			out.Query = string(in.String())
+		case "author":
+			out.Author = string(in.String())
+		case "statuses":
+			if in.IsNull() {
+				in.Skip()
+				out.Statuses = nil
+			} else {
+				in.Delim('[')
+				if out.Statuses == nil {
+					if !in.IsDelim(']') {
+						out.Statuses = make([]AdStatus, 0, 4)
+					} else {
+						out.Statuses = []AdStatus{}
+					}
+				} else {
+					out.Statuses = (out.Statuses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 AdStatus
+					v21 = AdStatus(in.String())
+					out.Statuses = append(out.Statuses, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "category":
+			out.Category = string(in.String())
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Attributes = make(map[string]string)
+				} else {
+					out.Attributes = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v22 string
+					v22 = string(in.String())
+					(out.Attributes)[key] = v22
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 string
+					v23 = string(in.String())
+					out.Tags = append(out.Tags, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tags_mode":
+			out.TagsMode = string(in.String())
+		case "lat":
+			if in.IsNull() {
+				in.Skip()
+				out.Lat = nil
+			} else {
+				if out.Lat == nil {
+					out.Lat = new(float64)
+				}
+				*out.Lat = float64(in.Float64())
+			}
+		case "lon":
+			if in.IsNull() {
+				in.Skip()
+				out.Lon = nil
+			} else {
+				if out.Lon == nil {
+					out.Lon = new(float64)
+				}
+				*out.Lon = float64(in.Float64())
+			}
+		case "radius_km":
+			out.RadiusKm = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels26(out *jwriter.Writer, in FilterResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sort_by\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SortBy))
+	}
+	{
+		const prefix string = ",\"order\":"
+		out.RawString(prefix)
+		out.String(string(in.Order))
+	}
+	{
+		const prefix string = ",\"price_min\":"
+		out.RawString(prefix)
+		(in.PriceMin).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"price_max\":"
+		out.RawString(prefix)
+		(in.PriceMax).MarshalEasyJSON(out)
+	}
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	if in.Query != "" {
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	if in.Author != "" {
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		out.String(string(in.Author))
+	}
+	if len(in.Statuses) != 0 {
+		const prefix string = ",\"statuses\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v24, v25 := range in.Statuses {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v25))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Category != "" {
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v26First := true
+			for v26Name, v26Value := range in.Attributes {
+				if v26First {
+					v26First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v26Name))
+				out.RawByte(':')
+				out.String(string(v26Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v27, v28 := range in.Tags {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v28))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.TagsMode != "" {
+		const prefix string = ",\"tags_mode\":"
+		out.RawString(prefix)
+		out.String(string(in.TagsMode))
+	}
+	if in.Lat != nil {
+		const prefix string = ",\"lat\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Lat))
+	}
+	if in.Lon != nil {
+		const prefix string = ",\"lon\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Lon))
+	}
+	if in.RadiusKm != 0 {
+		const prefix string = ",\"radius_km\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RadiusKm))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilterResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilterResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilterResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilterResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels26(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels27(in *jlexer.Lexer, out *Filter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Page":
+			out.Page = int(in.Int())
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "SortBy":
+			out.SortBy = string(in.String())
+		case "Order":
+			out.Order = string(in.String())
+		case "PriceMin":
+			out.PriceMin = int(in.Int())
+		case "PriceMax":
+			out.PriceMax = int(in.Int())
+		case "Currency":
+			out.Currency = string(in.String())
+		case "UserId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "Query":
+			out.Query = string(in.String())
+		case "Cursor":
+			if in.IsNull() {
+				in.Skip()
+				out.Cursor = nil
+			} else {
+				if out.Cursor == nil {
+					out.Cursor = new(Cursor)
+				}
+				(*out.Cursor).UnmarshalEasyJSON(in)
+			}
+		case "AuthorId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AuthorId).UnmarshalText(data))
+			}
+		case "AuthorLogin":
+			out.AuthorLogin = string(in.String())
+		case "Statuses":
+			if in.IsNull() {
+				in.Skip()
+				out.Statuses = nil
+			} else {
+				in.Delim('[')
+				if out.Statuses == nil {
+					if !in.IsDelim(']') {
+						out.Statuses = make([]AdStatus, 0, 4)
+					} else {
+						out.Statuses = []AdStatus{}
+					}
+				} else {
+					out.Statuses = (out.Statuses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v29 AdStatus
+					v29 = AdStatus(in.String())
+					out.Statuses = append(out.Statuses, v29)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "CategoryId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CategoryId).UnmarshalText(data))
+			}
+		case "Attributes":
+			if in.IsNull() {
+				in.Skip()
+				out.Attributes = nil
+			} else {
+				in.Delim('[')
+				if out.Attributes == nil {
+					if !in.IsDelim(']') {
+						out.Attributes = make([]AttributeFilter, 0, 1)
+					} else {
+						out.Attributes = []AttributeFilter{}
+					}
+				} else {
+					out.Attributes = (out.Attributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 AttributeFilter
+					(v30).UnmarshalEasyJSON(in)
+					out.Attributes = append(out.Attributes, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 string
+					v31 = string(in.String())
+					out.Tags = append(out.Tags, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "TagsAll":
+			out.TagsAll = bool(in.Bool())
+		case "Location":
+			if in.IsNull() {
+				in.Skip()
+				out.Location = nil
+			} else {
+				if out.Location == nil {
+					out.Location = new(GeoPoint)
+				}
+				(*out.Location).UnmarshalEasyJSON(in)
+			}
+		case "RadiusKm":
+			out.RadiusKm = float64(in.Float64())
+		case "FavoritesOf":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.FavoritesOf).UnmarshalText(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels27(out *jwriter.Writer, in Filter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Page\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Page))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"SortBy\":"
+		out.RawString(prefix)
+		out.String(string(in.SortBy))
+	}
+	{
+		const prefix string = ",\"Order\":"
+		out.RawString(prefix)
+		out.String(string(in.Order))
+	}
+	{
+		const prefix string = ",\"PriceMin\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceMin))
+	}
+	{
+		const prefix string = ",\"PriceMax\":"
+		out.RawString(prefix)
+		out.Int(int(in.PriceMax))
+	}
+	{
+		const prefix string = ",\"Currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"UserId\":"
+		out.RawString(prefix)
+		out.RawText((in.UserId).MarshalText())
+	}
+	{
+		const prefix string = ",\"Query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		if in.Cursor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cursor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"AuthorId\":"
+		out.RawString(prefix)
+		out.RawText((in.AuthorId).MarshalText())
+	}
+	{
+		const prefix string = ",\"AuthorLogin\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorLogin))
+	}
+	{
+		const prefix string = ",\"Statuses\":"
+		out.RawString(prefix)
+		if in.Statuses == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Statuses {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v33))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"CategoryId\":"
+		out.RawString(prefix)
+		out.RawText((in.CategoryId).MarshalText())
+	}
+	{
+		const prefix string = ",\"Attributes\":"
+		out.RawString(prefix)
+		if in.Attributes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v34, v35 := range in.Attributes {
+				if v34 > 0 {
+					out.RawByte(',')
+				}
+				(v35).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v36, v37 := range in.Tags {
+				if v36 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v37))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"TagsAll\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TagsAll))
+	}
+	{
+		const prefix string = ",\"Location\":"
+		out.RawString(prefix)
+		if in.Location == nil {
+			out.RawString("null")
+		} else {
+			(*in.Location).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"RadiusKm\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RadiusKm))
+	}
+	{
+		const prefix string = ",\"FavoritesOf\":"
+		out.RawString(prefix)
+		out.RawText((in.FavoritesOf).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Filter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Filter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Filter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Filter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels27(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels28(in *jlexer.Lexer, out *ExchangeRateReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rate":
+			out.Rate = in.JsonNumber()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels28(out *jwriter.Writer, in ExchangeRateReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rate\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Rate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExchangeRateReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExchangeRateReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExchangeRateReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExchangeRateReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels28(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels29(in *jlexer.Lexer, out *ExchangeRateList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ExchangeRateList, 0, 1)
+			} else {
+				*out = ExchangeRateList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v38 ExchangeRate
+			(v38).UnmarshalEasyJSON(in)
+			*out = append(*out, v38)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels29(out *jwriter.Writer, in ExchangeRateList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v39, v40 := range in {
+			if v39 > 0 {
+				out.RawByte(',')
+			}
+			(v40).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExchangeRateList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExchangeRateList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExchangeRateList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExchangeRateList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels29(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels30(in *jlexer.Lexer, out *ExchangeRate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currency":
+			out.Currency = string(in.String())
+		case "rate":
+			out.Rate = in.JsonNumber()
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels30(out *jwriter.Writer, in ExchangeRate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"rate\":"
+		out.RawString(prefix)
+		out.String(string(in.Rate))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExchangeRate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExchangeRate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExchangeRate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExchangeRate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels30(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels31(in *jlexer.Lexer, out *Event) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Type":
+			out.Type = EventType(in.String())
+		case "Data":
+			if m, ok := out.Data.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Data.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Data = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels31(out *jwriter.Writer, in Event) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"Data\":"
+		out.RawString(prefix)
+		if m, ok := in.Data.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Data.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Data))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Event) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Event) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Event) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels31(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels32(in *jlexer.Lexer, out *Cursor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "s":
+			out.SortBy = string(in.String())
+		case "o":
+			out.Order = string(in.String())
+		case "p":
+			out.Price = int(in.Int())
+		case "c":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "cu":
+			out.Currency = string(in.String())
+		case "i":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels32(out *jwriter.Writer, in Cursor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"s\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SortBy))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.String(string(in.Order))
+	}
+	if in.Price != 0 {
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Int(int(in.Price))
+	}
+	if true {
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cu\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.RawText((in.Id).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cursor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Cursor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cursor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Cursor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels32(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels33(in *jlexer.Lexer, out *ConversationRespList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ConversationRespList, 0, 0)
+			} else {
+				*out = ConversationRespList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v41 ConversationResp
+			(v41).UnmarshalEasyJSON(in)
+			*out = append(*out, v41)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels33(out *jwriter.Writer, in ConversationRespList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v42, v43 := range in {
+			if v42 > 0 {
+				out.RawByte(',')
+			}
+			(v43).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConversationRespList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConversationRespList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConversationRespList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConversationRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels33(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels34(in *jlexer.Lexer, out *ConversationResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "ad_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "ad_title":
+			out.AdTitle = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "peer_login":
+			out.PeerLogin = string(in.String())
+		case "read_only":
+			out.ReadOnly = bool(in.Bool())
+		case "unread_count":
+			out.UnreadCount = int(in.Int())
+		case "last_message":
+			if in.IsNull() {
+				in.Skip()
+				out.LastMessage = nil
+			} else {
+				if out.LastMessage == nil {
+					out.LastMessage = new(MessageResp)
+				}
+				(*out.LastMessage).UnmarshalEasyJSON(in)
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels34(out *jwriter.Writer, in ConversationResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"ad_id\":"
+		out.RawString(prefix)
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"ad_title\":"
+		out.RawString(prefix)
+		out.String(string(in.AdTitle))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"peer_login\":"
+		out.RawString(prefix)
+		out.String(string(in.PeerLogin))
+	}
+	{
+		const prefix string = ",\"read_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadOnly))
+	}
+	{
+		const prefix string = ",\"unread_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.UnreadCount))
+	}
+	if in.LastMessage != nil {
+		const prefix string = ",\"last_message\":"
+		out.RawString(prefix)
+		(*in.LastMessage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConversationResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConversationResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConversationResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConversationResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels34(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels35(in *jlexer.Lexer, out *Conversation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "AdId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "AdTitle":
+			out.AdTitle = string(in.String())
+		case "BuyerId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.BuyerId).UnmarshalText(data))
+			}
+		case "SellerId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SellerId).UnmarshalText(data))
+			}
+		case "PeerLogin":
+			out.PeerLogin = string(in.String())
+		case "ReadOnly":
+			out.ReadOnly = bool(in.Bool())
+		case "Unread":
+			out.Unread = int(in.Int())
+		case "LastMessage":
+			if in.IsNull() {
+				in.Skip()
+				out.LastMessage = nil
+			} else {
+				if out.LastMessage == nil {
+					out.LastMessage = new(Message)
+				}
+				(*out.LastMessage).UnmarshalEasyJSON(in)
+			}
+		case "CreatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels35(out *jwriter.Writer, in Conversation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"AdId\":"
+		out.RawString(prefix)
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"AdTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.AdTitle))
+	}
+	{
+		const prefix string = ",\"BuyerId\":"
+		out.RawString(prefix)
+		out.RawText((in.BuyerId).MarshalText())
+	}
+	{
+		const prefix string = ",\"SellerId\":"
+		out.RawString(prefix)
+		out.RawText((in.SellerId).MarshalText())
+	}
+	{
+		const prefix string = ",\"PeerLogin\":"
+		out.RawString(prefix)
+		out.String(string(in.PeerLogin))
+	}
+	{
+		const prefix string = ",\"ReadOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadOnly))
+	}
+	{
+		const prefix string = ",\"Unread\":"
+		out.RawString(prefix)
+		out.Int(int(in.Unread))
+	}
+	{
+		const prefix string = ",\"LastMessage\":"
+		out.RawString(prefix)
+		if in.LastMessage == nil {
+			out.RawString("null")
+		} else {
+			(*in.LastMessage).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"CreatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Conversation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Conversation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Conversation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Conversation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels35(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels36(in *jlexer.Lexer, out *CategoryRespList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(CategoryRespList, 0, 0)
+			} else {
+				*out = CategoryRespList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v44 CategoryResp
+			(v44).UnmarshalEasyJSON(in)
+			*out = append(*out, v44)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels36(out *jwriter.Writer, in CategoryRespList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v45, v46 := range in {
+			if v45 > 0 {
+				out.RawByte(',')
+			}
+			(v46).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryRespList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryRespList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryRespList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels36(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels37(in *jlexer.Lexer, out *CategoryResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "parent_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentId = nil
+			} else {
+				if out.ParentId == nil {
+					out.ParentId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ParentId).UnmarshalText(data))
+				}
+			}
+		case "attributes":
+			(out.Attributes).UnmarshalEasyJSON(in)
+		case "children":
+			(out.Children).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels37(out *jwriter.Writer, in CategoryResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.ParentId != nil {
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.RawText((*in.ParentId).MarshalText())
+	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		(in.Attributes).MarshalEasyJSON(out)
+	}
+	if len(in.Children) != 0 {
+		const prefix string = ",\"children\":"
+		out.RawString(prefix)
+		(in.Children).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels37(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels38(in *jlexer.Lexer, out *CategoryReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "parent_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentId = nil
+			} else {
+				if out.ParentId == nil {
+					out.ParentId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ParentId).UnmarshalText(data))
+				}
+			}
+		case "attributes":
+			(out.Attributes).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels38(out *jwriter.Writer, in CategoryReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.ParentId != nil {
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.RawText((*in.ParentId).MarshalText())
+	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		(in.Attributes).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels38(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels39(in *jlexer.Lexer, out *Category) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "ParentId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ParentId).UnmarshalText(data))
+			}
+		case "Name":
+			out.Name = string(in.String())
+		case "Attributes":
+			(out.Attributes).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels39(out *jwriter.Writer, in Category) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"ParentId\":"
+		out.RawString(prefix)
+		out.RawText((in.ParentId).MarshalText())
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Attributes\":"
+		out.RawString(prefix)
+		(in.Attributes).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Category) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Category) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Category) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Category) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels39(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels40(in *jlexer.Lexer, out *AttributeSchemaList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(AttributeSchemaList, 0, 0)
+			} else {
+				*out = AttributeSchemaList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v47 AttributeSchema
+			(v47).UnmarshalEasyJSON(in)
+			*out = append(*out, v47)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels40(out *jwriter.Writer, in AttributeSchemaList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v48, v49 := range in {
+			if v48 > 0 {
+				out.RawByte(',')
+			}
+			(v49).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttributeSchemaList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttributeSchemaList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttributeSchemaList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttributeSchemaList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels40(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels41(in *jlexer.Lexer, out *AttributeSchema) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = AttributeType(in.String())
+		case "required":
+			out.Required = bool(in.Bool())
+		case "min":
+			if in.IsNull() {
+				in.Skip()
+				out.Min = nil
+			} else {
+				if out.Min == nil {
+					out.Min = new(float64)
+				}
+				*out.Min = float64(in.Float64())
+			}
+		case "max":
+			if in.IsNull() {
+				in.Skip()
+				out.Max = nil
+			} else {
+				if out.Max == nil {
+					out.Max = new(float64)
+				}
+				*out.Max = float64(in.Float64())
+			}
+		case "values":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v50 string
+					v50 = string(in.String())
+					out.Values = append(out.Values, v50)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels41(out *jwriter.Writer, in AttributeSchema) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Required {
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Required))
+	}
+	if in.Min != nil {
+		const prefix string = ",\"min\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Min))
+	}
+	if in.Max != nil {
+		const prefix string = ",\"max\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Max))
+	}
+	if len(in.Values) != 0 {
+		const prefix string = ",\"values\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v51, v52 := range in.Values {
+				if v51 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v52))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttributeSchema) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttributeSchema) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttributeSchema) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttributeSchema) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels41(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels42(in *jlexer.Lexer, out *AttributeFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = string(in.String())
+		case "Op":
+			out.Op = string(in.String())
+		case "Value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels42(out *jwriter.Writer, in AttributeFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttributeFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttributeFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttributeFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttributeFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels42(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels43(in *jlexer.Lexer, out *AdView) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AdId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "Kind":
+			out.Kind = ViewKind(in.String())
+		case "Viewer":
+			out.Viewer = string(in.String())
+		case "ViewedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ViewedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels43(out *jwriter.Writer, in AdView) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AdId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Viewer\":"
+		out.RawString(prefix)
+		out.String(string(in.Viewer))
+	}
+	{
+		const prefix string = ",\"ViewedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ViewedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdView) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdView) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdView) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdView) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels43(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels44(in *jlexer.Lexer, out *AdVerification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = AdStatus(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "attempts":
+			out.Attempts = int(in.Int())
+		case "checked_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CheckedAt = nil
+			} else {
+				if out.CheckedAt == nil {
+					out.CheckedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CheckedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels44(out *jwriter.Writer, in AdVerification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"attempts\":"
+		out.RawString(prefix)
+		out.Int(int(in.Attempts))
+	}
+	if in.CheckedAt != nil {
+		const prefix string = ",\"checked_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CheckedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdVerification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdVerification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdVerification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdVerification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels44(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels45(in *jlexer.Lexer, out *AdTransitionReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = AdStatus(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels45(out *jwriter.Writer, in AdTransitionReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdTransitionReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdTransitionReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdTransitionReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdTransitionReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels45(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels46(in *jlexer.Lexer, out *AdStatsResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ad_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "from":
+			out.From = string(in.String())
+		case "to":
+			out.To = string(in.String())
+		case "views":
+			out.Views = int(in.Int())
+		case "impressions":
+			out.Impressions = int(in.Int())
+		case "days":
+			if in.IsNull() {
+				in.Skip()
+				out.Days = nil
+			} else {
+				in.Delim('[')
+				if out.Days == nil {
+					if !in.IsDelim(']') {
+						out.Days = make([]AdDayStatsResp, 0, 2)
+					} else {
+						out.Days = []AdDayStatsResp{}
+					}
+				} else {
+					out.Days = (out.Days)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v53 AdDayStatsResp
+					(v53).UnmarshalEasyJSON(in)
+					out.Days = append(out.Days, v53)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels46(out *jwriter.Writer, in AdStatsResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ad_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		out.String(string(in.From))
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.String(string(in.To))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"impressions\":"
+		out.RawString(prefix)
+		out.Int(int(in.Impressions))
+	}
+	{
+		const prefix string = ",\"days\":"
+		out.RawString(prefix)
+		if in.Days == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v54, v55 := range in.Days {
+				if v54 > 0 {
+					out.RawByte(',')
+				}
+				(v55).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdStatsResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdStatsResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdStatsResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdStatsResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels46(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels47(in *jlexer.Lexer, out *AdStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "From":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.From).UnmarshalJSON(data))
+			}
+		case "To":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.To).UnmarshalJSON(data))
+			}
+		case "Views":
+			out.Views = int(in.Int())
+		case "Impressions":
+			out.Impressions = int(in.Int())
+		case "Days":
+			if in.IsNull() {
+				in.Skip()
+				out.Days = nil
+			} else {
+				in.Delim('[')
+				if out.Days == nil {
+					if !in.IsDelim(']') {
+						out.Days = make([]AdDayStats, 0, 1)
+					} else {
+						out.Days = []AdDayStats{}
+					}
+				} else {
+					out.Days = (out.Days)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 AdDayStats
+					(v56).UnmarshalEasyJSON(in)
+					out.Days = append(out.Days, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels47(out *jwriter.Writer, in AdStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"From\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.From).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"To\":"
+		out.RawString(prefix)
+		out.Raw((in.To).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"Impressions\":"
+		out.RawString(prefix)
+		out.Int(int(in.Impressions))
+	}
+	{
+		const prefix string = ",\"Days\":"
+		out.RawString(prefix)
+		if in.Days == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.Days {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				(v58).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels47(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels48(in *jlexer.Lexer, out *AdRespList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(AdRespList, 0, 0)
+			} else {
+				*out = AdRespList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v59 AdResp
+			(v59).UnmarshalEasyJSON(in)
+			*out = append(*out, v59)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels48(out *jwriter.Writer, in AdRespList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v60, v61 := range in {
+			if v60 > 0 {
+				out.RawByte(',')
+			}
+			(v61).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdRespList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdRespList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdRespList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdRespList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels48(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels49(in *jlexer.Lexer, out *AdResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "currency":
+			out.Currency = string(in.String())
+		case "category_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CategoryId).UnmarshalText(data))
+			}
+		case "image_url":
+			out.ImageURL = string(in.String())
+		case "images":
+			(out.Images).UnmarshalEasyJSON(in)
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "author_login":
+			out.AuthorLogin = string(in.String())
+		case "version":
+			out.Version = int(in.Int())
+		case "status":
+			out.Status = AdStatus(in.String())
+		case "expires_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Attributes = make(map[string]interface{})
+				} else {
+					out.Attributes = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v62 interface{}
+					if m, ok := v62.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v62.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v62 = in.Interface()
+					}
+					(out.Attributes)[key] = v62
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v63 string
+					v63 = string(in.String())
+					out.Tags = append(out.Tags, v63)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "city":
+			out.City = string(in.String())
+		case "latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		case "distance_km":
+			if in.IsNull() {
+				in.Skip()
+				out.DistanceKm = nil
+			} else {
+				if out.DistanceKm == nil {
+					out.DistanceKm = new(float64)
+				}
+				*out.DistanceKm = float64(in.Float64())
+			}
+		case "is_owner":
+			out.IsOwner = bool(in.Bool())
+		case "original_price":
+			if in.IsNull() {
+				in.Skip()
+				out.OriginalPrice = nil
+			} else {
+				if out.OriginalPrice == nil {
+					out.OriginalPrice = new(Money)
+				}
+				(*out.OriginalPrice).UnmarshalEasyJSON(in)
+			}
+		case "original_currency":
+			out.OriginalCurrency = string(in.String())
+		case "price_dropped":
+			out.PriceDropped = bool(in.Bool())
+		case "previous_price":
+			if in.IsNull() {
+				in.Skip()
+				out.PreviousPrice = nil
+			} else {
+				if out.PreviousPrice == nil {
+					out.PreviousPrice = new(Money)
+				}
+				(*out.PreviousPrice).UnmarshalEasyJSON(in)
+			}
+		case "favorites_count":
+			out.FavoritesCount = int(in.Int())
+		case "is_favorite":
+			out.IsFavorite = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels49(out *jwriter.Writer, in AdResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		(in.Price).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"category_id\":"
+		out.RawString(prefix)
+		out.RawText((in.CategoryId).MarshalText())
+	}
+	{
+		const prefix string = ",\"image_url\":"
+		out.RawString(prefix)
+		out.String(string(in.ImageURL))
+	}
+	{
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		(in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"author_login\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorLogin))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int(int(in.Version))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v64First := true
+			for v64Name, v64Value := range in.Attributes {
+				if v64First {
+					v64First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v64Name))
+				out.RawByte(':')
+				if m, ok := v64Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v64Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v64Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v65, v66 := range in.Tags {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v66))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.City != "" {
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	if in.Latitude != nil {
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Latitude))
+	}
+	if in.Longitude != nil {
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Longitude))
+	}
+	if in.DistanceKm != nil {
+		const prefix string = ",\"distance_km\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.DistanceKm))
+	}
+	if in.IsOwner {
+		const prefix string = ",\"is_owner\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsOwner))
+	}
+	if in.OriginalPrice != nil {
+		const prefix string = ",\"original_price\":"
+		out.RawString(prefix)
+		(*in.OriginalPrice).MarshalEasyJSON(out)
+	}
+	if in.OriginalCurrency != "" {
+		const prefix string = ",\"original_currency\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalCurrency))
+	}
+	if in.PriceDropped {
+		const prefix string = ",\"price_dropped\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PriceDropped))
+	}
+	if in.PreviousPrice != nil {
+		const prefix string = ",\"previous_price\":"
+		out.RawString(prefix)
+		(*in.PreviousPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"favorites_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FavoritesCount))
+	}
+	if in.IsFavorite {
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdResp) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels5(w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels49(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels50(in *jlexer.Lexer, out *AdReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "image_url":
+			out.ImageURL = string(in.String())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]string, 0, 4)
+					} else {
+						out.Images = []string{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 string
+					v67 = string(in.String())
+					out.Images = append(out.Images, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "image_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ImageIds = nil
+			} else {
+				in.Delim('[')
+				if out.ImageIds == nil {
+					if !in.IsDelim(']') {
+						out.ImageIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.ImageIds = []uuid.UUID{}
+					}
+				} else {
+					out.ImageIds = (out.ImageIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v68 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v68).UnmarshalText(data))
+					}
+					out.ImageIds = append(out.ImageIds, v68)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "currency":
+			out.Currency = string(in.String())
+		case "category_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CategoryId).UnmarshalText(data))
+			}
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Attributes = make(map[string]interface{})
+				} else {
+					out.Attributes = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v69 interface{}
+					if m, ok := v69.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v69.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v69 = in.Interface()
+					}
+					(out.Attributes)[key] = v69
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 string
+					v70 = string(in.String())
+					out.Tags = append(out.Tags, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "city":
+			out.City = string(in.String())
+		case "latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		case "status":
+			out.Status = AdStatus(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels50(out *jwriter.Writer, in AdReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"image_url\":"
+		out.RawString(prefix)
+		out.String(string(in.ImageURL))
+	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v71, v72 := range in.Images {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v72))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.ImageIds) != 0 {
+		const prefix string = ",\"image_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v73, v74 := range in.ImageIds {
+				if v73 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v74).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		(in.Price).MarshalEasyJSON(out)
+	}
+	if in.Currency != "" {
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"category_id\":"
+		out.RawString(prefix)
+		out.RawText((in.CategoryId).MarshalText())
+	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v75First := true
+			for v75Name, v75Value := range in.Attributes {
+				if v75First {
+					v75First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v75Name))
+				out.RawByte(':')
+				if m, ok := v75Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v75Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v75Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v76, v77 := range in.Tags {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v77))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.City != "" {
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	if in.Latitude != nil {
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Latitude))
+	}
+	if in.Longitude != nil {
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Longitude))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels50(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels51(in *jlexer.Lexer, out *AdPatchReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			if in.IsNull() {
+				in.Skip()
+				out.Title = nil
+			} else {
+				if out.Title == nil {
+					out.Title = new(string)
+				}
+				*out.Title = string(in.String())
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "image_url":
+			if in.IsNull() {
+				in.Skip()
+				out.ImageURL = nil
+			} else {
+				if out.ImageURL == nil {
+					out.ImageURL = new(string)
+				}
+				*out.ImageURL = string(in.String())
+			}
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+				out.Price = nil
+			} else {
+				if out.Price == nil {
+					out.Price = new(Money)
+				}
+				(*out.Price).UnmarshalEasyJSON(in)
+			}
+		case "currency":
+			if in.IsNull() {
+				in.Skip()
+				out.Currency = nil
+			} else {
+				if out.Currency == nil {
+					out.Currency = new(string)
+				}
+				*out.Currency = string(in.String())
+			}
+		case "category_id":
+			if in.IsNull() {
+				in.Skip()
+				out.CategoryId = nil
+			} else {
+				if out.CategoryId == nil {
+					out.CategoryId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.CategoryId).UnmarshalText(data))
+				}
+			}
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Attributes = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v78 interface{}
+					if m, ok := v78.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v78.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v78 = in.Interface()
+					}
+					(out.Attributes)[key] = v78
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 string
+					v79 = string(in.String())
+					out.Tags = append(out.Tags, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "city":
+			if in.IsNull() {
+				in.Skip()
+				out.City = nil
+			} else {
+				if out.City == nil {
+					out.City = new(string)
+				}
+				*out.City = string(in.String())
+			}
+		case "latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels51(out *jwriter.Writer, in AdPatchReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		if in.Title == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Title))
+		}
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
+	}
+	{
+		const prefix string = ",\"image_url\":"
+		out.RawString(prefix)
+		if in.ImageURL == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.ImageURL))
+		}
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		if in.Price == nil {
+			out.RawString("null")
+		} else {
+			(*in.Price).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		if in.Currency == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Currency))
+		}
+	}
+	{
+		const prefix string = ",\"category_id\":"
+		out.RawString(prefix)
+		if in.CategoryId == nil {
+			out.RawString("null")
+		} else {
+			out.RawText((*in.CategoryId).MarshalText())
+		}
+	}
+	{
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		if in.Attributes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v80First := true
+			for v80Name, v80Value := range in.Attributes {
+				if v80First {
+					v80First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v80Name))
+				out.RawByte(':')
+				if m, ok := v80Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v80Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v80Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v81, v82 := range in.Tags {
+				if v81 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v82))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		if in.City == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.City))
+		}
+	}
+	{
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		if in.Latitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Latitude))
+		}
+	}
+	{
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		if in.Longitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Longitude))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdPatchReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdPatchReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdPatchReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdPatchReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels51(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels52(in *jlexer.Lexer, out *AdPatch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Title":
+			if in.IsNull() {
+				in.Skip()
+				out.Title = nil
+			} else {
+				if out.Title == nil {
+					out.Title = new(string)
+				}
+				*out.Title = string(in.String())
+			}
+		case "Description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "ImageURL":
+			if in.IsNull() {
+				in.Skip()
+				out.ImageURL = nil
+			} else {
+				if out.ImageURL == nil {
+					out.ImageURL = new(string)
+				}
+				*out.ImageURL = string(in.String())
+			}
+		case "Price":
+			if in.IsNull() {
+				in.Skip()
+				out.Price = nil
+			} else {
+				if out.Price == nil {
+					out.Price = new(int)
+				}
+				*out.Price = int(in.Int())
+			}
+		case "Currency":
+			if in.IsNull() {
+				in.Skip()
+				out.Currency = nil
+			} else {
+				if out.Currency == nil {
+					out.Currency = new(string)
+				}
+				*out.Currency = string(in.String())
+			}
+		case "CategoryId":
+			if in.IsNull() {
+				in.Skip()
+				out.CategoryId = nil
+			} else {
+				if out.CategoryId == nil {
+					out.CategoryId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.CategoryId).UnmarshalText(data))
+				}
+			}
+		case "Attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Attributes = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v83 interface{}
+					if m, ok := v83.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v83.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v83 = in.Interface()
+					}
+					(out.Attributes)[key] = v83
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v84 string
+					v84 = string(in.String())
+					out.Tags = append(out.Tags, v84)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "City":
+			if in.IsNull() {
+				in.Skip()
+				out.City = nil
+			} else {
+				if out.City == nil {
+					out.City = new(string)
+				}
+				*out.City = string(in.String())
+			}
+		case "Latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "Longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels52(out *jwriter.Writer, in AdPatch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Title\":"
+		out.RawString(prefix[1:])
+		if in.Title == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Title))
+		}
+	}
+	{
+		const prefix string = ",\"Description\":"
+		out.RawString(prefix)
+		if in.Description == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Description))
+		}
+	}
+	{
+		const prefix string = ",\"ImageURL\":"
+		out.RawString(prefix)
+		if in.ImageURL == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.ImageURL))
+		}
+	}
+	{
+		const prefix string = ",\"Price\":"
+		out.RawString(prefix)
+		if in.Price == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.Price))
+		}
+	}
+	{
+		const prefix string = ",\"Currency\":"
+		out.RawString(prefix)
+		if in.Currency == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Currency))
+		}
+	}
+	{
+		const prefix string = ",\"CategoryId\":"
+		out.RawString(prefix)
+		if in.CategoryId == nil {
+			out.RawString("null")
+		} else {
+			out.RawText((*in.CategoryId).MarshalText())
+		}
+	}
+	{
+		const prefix string = ",\"Attributes\":"
+		out.RawString(prefix)
+		if in.Attributes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v85First := true
+			for v85Name, v85Value := range in.Attributes {
+				if v85First {
+					v85First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v85Name))
+				out.RawByte(':')
+				if m, ok := v85Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v85Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v85Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v86, v87 := range in.Tags {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v87))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"City\":"
+		out.RawString(prefix)
+		if in.City == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.City))
+		}
+	}
+	{
+		const prefix string = ",\"Latitude\":"
+		out.RawString(prefix)
+		if in.Latitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Latitude))
+		}
+	}
+	{
+		const prefix string = ",\"Longitude\":"
+		out.RawString(prefix)
+		if in.Longitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Longitude))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdPatch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdPatch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdPatch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdPatch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels52(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels53(in *jlexer.Lexer, out *AdPageResp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			(out.Items).UnmarshalEasyJSON(in)
+		case "total":
+			out.Total = int(in.Int())
+		case "page":
+			out.Page = int(in.Int())
+		case "limit":
+			out.Limit = int(in.Int())
+		case "has_next":
+			out.HasNext = bool(in.Bool())
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		case "filter":
+			(out.Filter).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels53(out *jwriter.Writer, in AdPageResp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		(in.Items).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		out.Int(int(in.Page))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"has_next\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasNext))
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
+		(in.Filter).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdPageResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdPageResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdPageResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdPageResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels53(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels54(in *jlexer.Lexer, out *AdModeratedEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ad_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AdId).UnmarshalText(data))
+			}
+		case "status":
+			out.Status = AdStatus(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels54(out *jwriter.Writer, in AdModeratedEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ad_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AdId).MarshalText())
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdModeratedEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdModeratedEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdModeratedEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdModeratedEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels54(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels55(in *jlexer.Lexer, out *AdList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Ads":
+			if in.IsNull() {
+				in.Skip()
+				out.Ads = nil
+			} else {
+				in.Delim('[')
+				if out.Ads == nil {
+					if !in.IsDelim(']') {
+						out.Ads = make([]Ad, 0, 0)
+					} else {
+						out.Ads = []Ad{}
+					}
+				} else {
+					out.Ads = (out.Ads)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 Ad
+					(v88).UnmarshalEasyJSON(in)
+					out.Ads = append(out.Ads, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "HasNext":
+			out.HasNext = bool(in.Bool())
+		case "NextCursor":
+			out.NextCursor = string(in.String())
+		case "Total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels55(out *jwriter.Writer, in AdList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Ads\":"
+		out.RawString(prefix[1:])
+		if in.Ads == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.Ads {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				(v90).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"HasNext\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasNext))
+	}
+	{
+		const prefix string = ",\"NextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	{
+		const prefix string = ",\"Total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels55(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels56(in *jlexer.Lexer, out *AdImageReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.URL = string(in.String())
+		case "image_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ImageId = nil
+			} else {
+				if out.ImageId == nil {
+					out.ImageId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ImageId).UnmarshalText(data))
+				}
+			}
+		case "is_cover":
+			out.IsCover = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels56(out *jwriter.Writer, in AdImageReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.URL))
+	}
+	if in.ImageId != nil {
+		const prefix string = ",\"image_id\":"
+		out.RawString(prefix)
+		out.RawText((*in.ImageId).MarshalText())
+	}
+	if in.IsCover {
+		const prefix string = ",\"is_cover\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCover))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdImageReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdImageReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdImageReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdImageReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels56(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels57(in *jlexer.Lexer, out *AdImageOrderReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "image_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ImageIds = nil
+			} else {
+				in.Delim('[')
+				if out.ImageIds == nil {
+					if !in.IsDelim(']') {
+						out.ImageIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.ImageIds = []uuid.UUID{}
+					}
+				} else {
+					out.ImageIds = (out.ImageIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v91).UnmarshalText(data))
+					}
+					out.ImageIds = append(out.ImageIds, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cover_id":
+			if in.IsNull() {
+				in.Skip()
+				out.CoverId = nil
+			} else {
+				if out.CoverId == nil {
+					out.CoverId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.CoverId).UnmarshalText(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels57(out *jwriter.Writer, in AdImageOrderReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"image_ids\":"
+		out.RawString(prefix[1:])
+		if in.ImageIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.ImageIds {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v93).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"cover_id\":"
+		out.RawString(prefix)
+		if in.CoverId == nil {
+			out.RawString("null")
+		} else {
+			out.RawText((*in.CoverId).MarshalText())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdImageOrderReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdImageOrderReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdImageOrderReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdImageOrderReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels57(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels58(in *jlexer.Lexer, out *AdImageList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(AdImageList, 0, 1)
+			} else {
+				*out = AdImageList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v94 AdImage
+			(v94).UnmarshalEasyJSON(in)
+			*out = append(*out, v94)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels58(out *jwriter.Writer, in AdImageList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v95, v96 := range in {
+			if v95 > 0 {
+				out.RawByte(',')
+			}
+			(v96).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdImageList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdImageList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdImageList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdImageList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels58(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels59(in *jlexer.Lexer, out *AdImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "url":
+			out.URL = string(in.String())
+		case "image_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ImageId = nil
+			} else {
+				if out.ImageId == nil {
+					out.ImageId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ImageId).UnmarshalText(data))
+				}
+			}
+		case "thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Thumbnails = make(map[string]string)
+				} else {
+					out.Thumbnails = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v97 string
+					v97 = string(in.String())
+					(out.Thumbnails)[key] = v97
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "position":
+			out.Position = int(in.Int())
+		case "is_cover":
+			out.IsCover = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels59(out *jwriter.Writer, in AdImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	if in.ImageId != nil {
+		const prefix string = ",\"image_id\":"
+		out.RawString(prefix)
+		out.RawText((*in.ImageId).MarshalText())
+	}
+	if len(in.Thumbnails) != 0 {
+		const prefix string = ",\"thumbnails\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v98First := true
+			for v98Name, v98Value := range in.Thumbnails {
+				if v98First {
+					v98First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v98Name))
+				out.RawByte(':')
+				out.String(string(v98Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"is_cover\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCover))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdImage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdImage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels59(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AdResp) UnmarshalJSON(data []byte) error {
+func (v *AdImage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels59(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AdResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels5(l, v)
+func (v *AdImage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels59(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(in *jlexer.Lexer, out *AdReq) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels60(in *jlexer.Lexer, out *AdDayStatsResp) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -561,14 +7022,12 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(in *jlex
 			continue
 		}
 		switch key {
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "image_url":
-			out.ImageURL = string(in.String())
-		case "price":
-			out.Price = float64(in.Float64())
+		case "date":
+			out.Date = string(in.String())
+		case "views":
+			out.Views = int(in.Int())
+		case "impressions":
+			out.Impressions = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -579,57 +7038,134 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(in *jlex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels6(out *jwriter.Writer, in AdReq) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels60(out *jwriter.Writer, in AdDayStatsResp) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"date\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Title))
+		out.String(string(in.Date))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"views\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Int(int(in.Views))
 	}
 	{
-		const prefix string = ",\"image_url\":"
+		const prefix string = ",\"impressions\":"
 		out.RawString(prefix)
-		out.String(string(in.ImageURL))
+		out.Int(int(in.Impressions))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdDayStatsResp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdDayStatsResp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdDayStatsResp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdDayStatsResp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels60(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels61(in *jlexer.Lexer, out *AdDayStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Day":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Day).UnmarshalJSON(data))
+			}
+		case "Views":
+			out.Views = int(in.Int())
+		case "Impressions":
+			out.Impressions = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels61(out *jwriter.Writer, in AdDayStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"price\":"
+		const prefix string = ",\"Day\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Day).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"Impressions\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		out.Int(int(in.Impressions))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AdReq) MarshalJSON() ([]byte, error) {
+func (v AdDayStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels6(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AdReq) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels6(w, v)
+func (v AdDayStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AdReq) UnmarshalJSON(data []byte) error {
+func (v *AdDayStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AdReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels6(l, v)
+func (v *AdDayStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels61(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(in *jlexer.Lexer, out *Ad) {
+func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels62(in *jlexer.Lexer, out *Ad) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -656,20 +7192,151 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(in *jlex
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.UserId).UnmarshalText(data))
 			}
+		case "CategoryId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CategoryId).UnmarshalText(data))
+			}
 		case "Title":
 			out.Title = string(in.String())
 		case "Description":
 			out.Description = string(in.String())
 		case "Price":
 			out.Price = int(in.Int())
+		case "Currency":
+			out.Currency = string(in.String())
+		case "BasePrice":
+			out.BasePrice = int(in.Int())
+		case "DisplayPrice":
+			if in.IsNull() {
+				in.Skip()
+				out.DisplayPrice = nil
+			} else {
+				if out.DisplayPrice == nil {
+					out.DisplayPrice = new(int)
+				}
+				*out.DisplayPrice = int(in.Int())
+			}
+		case "PreviousPrice":
+			if in.IsNull() {
+				in.Skip()
+				out.PreviousPrice = nil
+			} else {
+				if out.PreviousPrice == nil {
+					out.PreviousPrice = new(int)
+				}
+				*out.PreviousPrice = int(in.Int())
+			}
+		case "DisplayPreviousPrice":
+			if in.IsNull() {
+				in.Skip()
+				out.DisplayPreviousPrice = nil
+			} else {
+				if out.DisplayPreviousPrice == nil {
+					out.DisplayPreviousPrice = new(int)
+				}
+				*out.DisplayPreviousPrice = int(in.Int())
+			}
 		case "ImageURL":
 			out.ImageURL = string(in.String())
+		case "Images":
+			(out.Images).UnmarshalEasyJSON(in)
 		case "CreatedAt":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
 		case "AuthorLogin":
 			out.AuthorLogin = string(in.String())
+		case "Version":
+			out.Version = int(in.Int())
+		case "Status":
+			out.Status = AdStatus(in.String())
+		case "PendingStatus":
+			out.PendingStatus = AdStatus(in.String())
+		case "ExpiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "Attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Attributes = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v99 interface{}
+					if m, ok := v99.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v99.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v99 = in.Interface()
+					}
+					(out.Attributes)[key] = v99
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v100 string
+					v100 = string(in.String())
+					out.Tags = append(out.Tags, v100)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "City":
+			out.City = string(in.String())
+		case "Latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "Longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		case "DistanceKm":
+			if in.IsNull() {
+				in.Skip()
+				out.DistanceKm = nil
+			} else {
+				if out.DistanceKm == nil {
+					out.DistanceKm = new(float64)
+				}
+				*out.DistanceKm = float64(in.Float64())
+			}
+		case "FavoritesCount":
+			out.FavoritesCount = int(in.Int())
+		case "IsFavorite":
+			out.IsFavorite = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -680,7 +7347,7 @@ func easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(in *jlex
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(out *jwriter.Writer, in Ad) {
+func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels62(out *jwriter.Writer, in Ad) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -694,6 +7361,11 @@ func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(out *jwr
 		out.RawString(prefix)
 		out.RawText((in.UserId).MarshalText())
 	}
+	{
+		const prefix string = ",\"CategoryId\":"
+		out.RawString(prefix)
+		out.RawText((in.CategoryId).MarshalText())
+	}
 	{
 		const prefix string = ",\"Title\":"
 		out.RawString(prefix)
@@ -709,11 +7381,53 @@ func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(out *jwr
 		out.RawString(prefix)
 		out.Int(int(in.Price))
 	}
+	{
+		const prefix string = ",\"Currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"BasePrice\":"
+		out.RawString(prefix)
+		out.Int(int(in.BasePrice))
+	}
+	{
+		const prefix string = ",\"DisplayPrice\":"
+		out.RawString(prefix)
+		if in.DisplayPrice == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.DisplayPrice))
+		}
+	}
+	{
+		const prefix string = ",\"PreviousPrice\":"
+		out.RawString(prefix)
+		if in.PreviousPrice == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.PreviousPrice))
+		}
+	}
+	{
+		const prefix string = ",\"DisplayPreviousPrice\":"
+		out.RawString(prefix)
+		if in.DisplayPreviousPrice == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.DisplayPreviousPrice))
+		}
+	}
 	{
 		const prefix string = ",\"ImageURL\":"
 		out.RawString(prefix)
 		out.String(string(in.ImageURL))
 	}
+	{
+		const prefix string = ",\"Images\":"
+		out.RawString(prefix)
+		(in.Images).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"CreatedAt\":"
 		out.RawString(prefix)
@@ -724,29 +7438,134 @@ func easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(out *jwr
 		out.RawString(prefix)
 		out.String(string(in.AuthorLogin))
 	}
+	{
+		const prefix string = ",\"Version\":"
+		out.RawString(prefix)
+		out.Int(int(in.Version))
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"PendingStatus\":"
+		out.RawString(prefix)
+		out.String(string(in.PendingStatus))
+	}
+	{
+		const prefix string = ",\"ExpiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Attributes\":"
+		out.RawString(prefix)
+		if in.Attributes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v101First := true
+			for v101Name, v101Value := range in.Attributes {
+				if v101First {
+					v101First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v101Name))
+				out.RawByte(':')
+				if m, ok := v101Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v101Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v101Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v102, v103 := range in.Tags {
+				if v102 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v103))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"City\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"Latitude\":"
+		out.RawString(prefix)
+		if in.Latitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Latitude))
+		}
+	}
+	{
+		const prefix string = ",\"Longitude\":"
+		out.RawString(prefix)
+		if in.Longitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Longitude))
+		}
+	}
+	{
+		const prefix string = ",\"DistanceKm\":"
+		out.RawString(prefix)
+		if in.DistanceKm == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.DistanceKm))
+		}
+	}
+	{
+		const prefix string = ",\"FavoritesCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.FavoritesCount))
+	}
+	{
+		const prefix string = ",\"IsFavorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Ad) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(&w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels62(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Ad) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels7(w, v)
+	easyjsonD2b7633eEncodeGithubComK1tten2005GoVkInternInternalModels62(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Ad) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(&r, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels62(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Ad) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels7(l, v)
+	easyjsonD2b7633eDecodeGithubComK1tten2005GoVkInternInternalModels62(l, v)
 }
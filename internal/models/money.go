@@ -0,0 +1,107 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// maxMoneyDigits keeps minor units of any parsed amount within int64.
+const maxMoneyDigits = 15
+
+var ErrInvalidMoney = errors.New("invalid amount: a decimal number with at most two fractional digits expected")
+
+// Money is an amount in minor units of its currency, which are always
+// hundredths: currencies with another exponent are rejected by
+// validation.ValidCurrency. Outside the service it is a decimal number of
+// major units with at most two fractional digits, so 19.99 is exactly 1999
+// and never goes through float64.
+type Money int64
+
+// ParseMoney parses a decimal amount of major units like "20", "19.9" or "19.99".
+// Exponents, more than two fractional digits and a dot without digits on
+// both sides are rejected.
+func ParseMoney(s string) (Money, error) {
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac, hasDot := strings.Cut(s, ".")
+	if whole == "" || len(whole) > maxMoneyDigits || (hasDot && (frac == "" || len(frac) > 2)) {
+		return 0, ErrInvalidMoney
+	}
+	if !digitsOnly(whole) || !digitsOnly(frac) {
+		return 0, ErrInvalidMoney
+	}
+
+	frac += strings.Repeat("0", 2-len(frac))
+	minor, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidMoney
+	}
+	if negative {
+		minor = -minor
+	}
+	return Money(minor), nil
+}
+
+func digitsOnly(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats m without trailing fractional zeros: 20, 19.9, 19.99.
+func (m Money) String() string {
+	minor := int64(m)
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	result := sign + strconv.FormatInt(minor/100, 10)
+	if frac := minor % 100; frac != 0 {
+		result += strings.TrimSuffix("."+strconv.FormatInt(100+frac, 10)[1:], "0")
+	}
+	return result
+}
+
+// MarshalEasyJSON writes m as a JSON number.
+func (m Money) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString(m.String())
+}
+
+// UnmarshalEasyJSON accepts a JSON number or a string holding one, the
+// digits are taken as written.
+func (m *Money) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+		return
+	}
+	number := l.JsonNumber()
+	if !l.Ok() {
+		return
+	}
+	parsed, err := ParseMoney(string(number))
+	if err != nil {
+		l.AddError(err)
+		return
+	}
+	*m = parsed
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	m.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
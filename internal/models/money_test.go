@@ -0,0 +1,73 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr bool
+	}{
+		{"20", 2000, false},
+		{"19.9", 1990, false},
+		{"19.99", 1999, false},
+		{"0.07", 7, false},
+		{"-5.5", -550, false},
+		{"1000000", 100000000, false},
+		{"19.999", 0, true},
+		{"1e3", 0, true},
+		{"19.", 0, true},
+		{".5", 0, true},
+		{"", 0, true},
+		{"1 000", 0, true},
+		{"+5", 0, true},
+		{"1234567890123456", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMoney(tt.in)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidMoney)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	for money, want := range map[Money]string{0: "0", 2000: "20", 1990: "19.9", 1999: "19.99", 5: "0.05", -550: "-5.5"} {
+		assert.Equal(t, want, money.String())
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var req AdReq
+	assert.NoError(t, easyjson.Unmarshal([]byte(`{"title":"a","price":19.99}`), &req))
+	assert.Equal(t, Money(1999), req.Price)
+
+	assert.NoError(t, easyjson.Unmarshal([]byte(`{"price":"0.10"}`), &req))
+	assert.Equal(t, Money(10), req.Price)
+
+	assert.Error(t, easyjson.Unmarshal([]byte(`{"price":19.999}`), &req))
+
+	var patch AdPatchReq
+	assert.NoError(t, easyjson.Unmarshal([]byte(`{"price":null}`), &patch))
+	assert.Nil(t, patch.Price)
+
+	data, err := json.Marshal(AdResp{Price: 1999})
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"price":19.99`)
+
+	var resp AdResp
+	assert.NoError(t, easyjson.Unmarshal(data, &resp))
+	assert.Equal(t, Money(1999), resp.Price)
+}
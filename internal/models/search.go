@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// SavedSearch is a GetAds filter a user saved under a name. Query is the
+// query string of GetAds the filter was parsed from, it is what the search
+// is shown and re-run with.
+type SavedSearch struct {
+	Id        uuid.UUID
+	UserId    uuid.UUID
+	Name      string
+	Query     string
+	Filter    Filter
+	CreatedAt time.Time
+}
+
+// SavedSearchReq takes the query string of GetAds without the leading "?",
+// e.g. "q=велосипед&price_max=20000".
+//
+// easyjson:json
+type SavedSearchReq struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+// easyjson:json
+type SavedSearchResp struct {
+	Id        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Query     string     `json:"query"`
+	Filter    FilterResp `json:"filter"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+//easyjson:json
+type SavedSearchRespList []SavedSearchResp
+
+// Notification tells a user that a new ad matched one of their saved
+// searches.
+type Notification struct {
+	Id         uuid.UUID
+	UserId     uuid.UUID
+	SearchId   uuid.UUID
+	SearchName string
+	AdId       uuid.UUID
+	AdTitle    string
+	CreatedAt  time.Time
+}
+
+// easyjson:json
+type NotificationResp struct {
+	Id         uuid.UUID `json:"id"`
+	SearchId   uuid.UUID `json:"search_id"`
+	SearchName string    `json:"search_name"`
+	AdId       uuid.UUID `json:"ad_id"`
+	AdTitle    string    `json:"ad_title"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+//easyjson:json
+type NotificationRespList []NotificationResp
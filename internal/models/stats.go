@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+type ViewKind string
+
+const (
+	// ViewKindView is an open ad page, ViewKindImpression is an ad shown
+	// in the results of GetAds.
+	ViewKindView       ViewKind = "view"
+	ViewKindImpression ViewKind = "impression"
+)
+
+// Viewer is who looked at an ad. UserId is uuid.Nil for anonymous viewers,
+// Key tells viewers apart and is what views are deduplicated by.
+type Viewer struct {
+	UserId uuid.UUID
+	Key    string
+}
+
+type AdView struct {
+	AdId     uuid.UUID
+	Kind     ViewKind
+	Viewer   string
+	ViewedAt time.Time
+}
+
+// AdDayStats are the counts of one UTC day.
+type AdDayStats struct {
+	Day         time.Time
+	Views       int
+	Impressions int
+}
+
+// AdStats are the counts of an ad over the days from From to To, Days has
+// an entry for each of them.
+type AdStats struct {
+	From        time.Time
+	To          time.Time
+	Views       int
+	Impressions int
+	Days        []AdDayStats
+}
+
+// easyjson:json
+type AdDayStatsResp struct {
+	Date        string `json:"date"`
+	Views       int    `json:"views"`
+	Impressions int    `json:"impressions"`
+}
+
+// easyjson:json
+type AdStatsResp struct {
+	AdId        uuid.UUID        `json:"ad_id"`
+	From        string           `json:"from"`
+	To          string           `json:"to"`
+	Views       int              `json:"views"`
+	Impressions int              `json:"impressions"`
+	Days        []AdDayStatsResp `json:"days"`
+}
@@ -11,6 +11,7 @@ type User struct {
 	Id           uuid.UUID `json:"id"`
 	Login        string    `json:"login"`
 	PasswordHash []byte    `json:"-"`
+	IsAdmin      bool      `json:"-"`
 }
 
 // easyjson:json
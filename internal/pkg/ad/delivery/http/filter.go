@@ -0,0 +1,380 @@
+package http
+
+import (
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/cursor"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/satori/uuid"
+)
+
+// parseFilter reads GetAds query parameters. Out of range paging and
+// sorting values fall back to defaults, while filters which would otherwise
+// be dropped and widen the results, like a malformed price bound, are
+// reported as errors together with a malformed cursor or currency.
+func parseFilter(q url.Values) (models.Filter, error) {
+	page, _ := strconv.Atoi(q.Get("page"))
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	sortBy := q.Get("sort_by")
+	order := q.Get("order")
+	priceMin, err := parsePriceBound(q, "price_min")
+	if err != nil {
+		return models.Filter{}, err
+	}
+	priceMax, err := parsePriceBound(q, "price_max")
+	if err != nil {
+		return models.Filter{}, err
+	}
+	if priceMax > 0 && priceMin > priceMax {
+		return models.Filter{}, ad.ErrInvalidPrice
+	}
+	search := strings.TrimSpace(q.Get("q"))
+	author := strings.TrimSpace(q.Get("author"))
+	categoryId, err := parseCategory(q)
+	if err != nil {
+		return models.Filter{}, err
+	}
+	attributes, err := parseAttributeFilters(q)
+	if err != nil {
+		return models.Filter{}, err
+	}
+	tags, err := parseTags(q)
+	if err != nil {
+		return models.Filter{}, err
+	}
+	location, err := parseLocation(q)
+	if err != nil {
+		return models.Filter{}, err
+	}
+	radiusKm, err := parseRadius(q)
+	if err != nil {
+		return models.Filter{}, err
+	}
+	currency := strings.ToUpper(strings.TrimSpace(q.Get("currency")))
+	if currency != "" && !validation.ValidCurrency(currency) {
+		return models.Filter{}, ad.ErrUnknownCurrency
+	}
+
+	// bounds are compared with prices converted to rubles, which may exceed
+	// MaxPrice, so they are not capped by it and a missing price_max means
+	// no upper bound
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 10
+	}
+	if runes := []rune(search); len(runes) > validation.MaxSearchQueryLength {
+		search = string(runes[:validation.MaxSearchQueryLength])
+	}
+	if sortBy == "relevance" && search == "" {
+		sortBy = "created_at"
+	}
+	if sortBy == "distance" && location == nil {
+		sortBy = "created_at"
+	}
+	if location == nil {
+		radiusKm = 0
+	}
+	if radiusKm > validation.MaxRadiusKm {
+		radiusKm = validation.MaxRadiusKm
+	}
+	if sortBy != "price" && sortBy != "created_at" && sortBy != "relevance" && sortBy != "distance" && sortBy != "price_drop" {
+		sortBy = "created_at"
+	}
+	if order != "asc" && order != "desc" {
+		order = "asc"
+		// the best matches and the biggest discounts go first
+		if sortBy == "relevance" || sortBy == "price_drop" {
+			order = "desc"
+		}
+	}
+
+	mode := q.Get("tags_mode")
+	if mode != "" && mode != "any" && mode != "all" {
+		return models.Filter{}, ad.ErrInvalidTagsMode
+	}
+
+	var adsCursor *models.Cursor
+	if token := q.Get("cursor"); token != "" {
+		c, err := cursor.Decode(token)
+		if err != nil {
+			return models.Filter{}, err
+		}
+		if !cursor.Matches(c, models.Filter{Currency: currency}) {
+			return models.Filter{}, cursor.ErrInvalidCursor
+		}
+		adsCursor = &c
+		sortBy, order = c.SortBy, c.Order
+	}
+
+	filter := models.Filter{
+		Page:       page,
+		Limit:      limit,
+		SortBy:     sortBy,
+		Order:      order,
+		PriceMin:   int(priceMin),
+		PriceMax:   int(priceMax),
+		Currency:   currency,
+		Query:      search,
+		Cursor:     adsCursor,
+		CategoryId: categoryId,
+		Attributes: attributes,
+		Tags:       tags,
+		TagsAll:    mode == "all",
+		Location:   location,
+		RadiusKm:   radiusKm,
+	}
+
+	// author is either a user id or a login, logins are at most 20 characters
+	// long so they never parse as a uuid
+	if id, err := uuid.FromString(author); err == nil {
+		filter.AuthorId = id
+	} else {
+		filter.AuthorLogin = author
+	}
+
+	return filter, nil
+}
+
+func toFilterResp(filter models.Filter) models.FilterResp {
+	resp := models.FilterResp{
+		SortBy:     filter.SortBy,
+		Order:      filter.Order,
+		PriceMin:   models.Money(filter.PriceMin),
+		PriceMax:   models.Money(filter.PriceMax),
+		Currency:   filter.Currency,
+		Query:      filter.Query,
+		Author:     filterAuthor(filter),
+		Statuses:   filter.Statuses,
+		Category:   filterCategory(filter),
+		Attributes: filterAttributes(filter),
+		Tags:       filter.Tags,
+		TagsMode:   tagsMode(filter),
+		RadiusKm:   filter.RadiusKm,
+	}
+	if filter.Location != nil {
+		resp.Lat, resp.Lon = &filter.Location.Lat, &filter.Location.Lon
+	}
+	return resp
+}
+
+// parsePriceBound reads price_min or price_max. A missing bound is 0.
+func parsePriceBound(q url.Values, key string) (models.Money, error) {
+	value := strings.TrimSpace(q.Get(key))
+	if value == "" {
+		return 0, nil
+	}
+	price, err := models.ParseMoney(value)
+	if err != nil || price < 0 {
+		return 0, ad.ErrInvalidPrice
+	}
+	return price, nil
+}
+
+// parseCategory reads the category parameter, a missing category is
+// uuid.Nil.
+func parseCategory(q url.Values) (uuid.UUID, error) {
+	value := strings.TrimSpace(q.Get("category"))
+	if value == "" {
+		return uuid.Nil, nil
+	}
+	categoryId, err := uuid.FromString(value)
+	if err != nil {
+		return uuid.Nil, ad.ErrInvalidCategory
+	}
+	return categoryId, nil
+}
+
+// parseLocation reads the lat and lon parameters. A missing location is
+// nil, a half given one or one off the Earth is an error.
+func parseLocation(q url.Values) (*models.GeoPoint, error) {
+	latValue, lonValue := strings.TrimSpace(q.Get("lat")), strings.TrimSpace(q.Get("lon"))
+	if latValue == "" && lonValue == "" {
+		return nil, nil
+	}
+	lat, err := strconv.ParseFloat(latValue, 64)
+	if err != nil {
+		return nil, ad.ErrInvalidLocation
+	}
+	lon, err := strconv.ParseFloat(lonValue, 64)
+	if err != nil {
+		return nil, ad.ErrInvalidLocation
+	}
+	if !validation.ValidCoordinates(lat, lon) {
+		return nil, ad.ErrInvalidLocation
+	}
+	return &models.GeoPoint{Lat: lat, Lon: lon}, nil
+}
+
+// parseRadius reads radius_km. A missing radius is 0, which means no
+// distance limit.
+func parseRadius(q url.Values) (float64, error) {
+	value := strings.TrimSpace(q.Get("radius_km"))
+	if value == "" {
+		return 0, nil
+	}
+	radiusKm, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(radiusKm) || radiusKm < 0 {
+		return 0, ad.ErrInvalidLocation
+	}
+	return radiusKm, nil
+}
+
+// parseTags reads tags given as a comma separated list, as repeated
+// parameters or both. Tags are normalized the same way as tags of ads,
+// empty items are ignored, invalid tags and more than MaxTagsPerAd distinct
+// ones are errors.
+func parseTags(q url.Values) ([]string, error) {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, param := range q["tags"] {
+		for _, tag := range strings.Split(param, ",") {
+			if strings.TrimSpace(tag) == "" {
+				continue
+			}
+			normalized, ok := validation.NormalizeTag(tag)
+			if !ok {
+				return nil, ad.ErrInvalidTag
+			}
+			if seen[normalized] {
+				continue
+			}
+			if len(tags) == validation.MaxTagsPerAd {
+				return nil, ad.ErrInvalidTag
+			}
+			seen[normalized] = true
+			tags = append(tags, normalized)
+		}
+	}
+	return tags, nil
+}
+
+func tagsMode(filter models.Filter) string {
+	switch {
+	case len(filter.Tags) == 0:
+		return ""
+	case filter.TagsAll:
+		return "all"
+	default:
+		return "any"
+	}
+}
+
+// maxAttributeFilters bounds the number of attr.* parameters of one request,
+// each of them adds a condition to the query.
+const maxAttributeFilters = 10
+
+// parseAttributeFilters reads attr.<name>, attr.<name>_min and
+// attr.<name>_max parameters. Empty ones are ignored, malformed names, non
+// numeric bounds and too many filters are errors.
+func parseAttributeFilters(q url.Values) ([]models.AttributeFilter, error) {
+	keys := make([]string, 0)
+	for key := range q {
+		if strings.HasPrefix(key, "attr.") {
+			keys = append(keys, key)
+		}
+	}
+	// the same parameters always give the same query text
+	sort.Strings(keys)
+
+	var filters []models.AttributeFilter
+	for _, key := range keys {
+		value := strings.TrimSpace(q.Get(key))
+		if value == "" {
+			continue
+		}
+		if len(filters) == maxAttributeFilters {
+			return nil, ad.ErrInvalidAttribute
+		}
+
+		name, op := strings.TrimPrefix(key, "attr."), "eq"
+		if n, ok := strings.CutSuffix(name, "_min"); ok {
+			name, op = n, "min"
+		} else if n, ok := strings.CutSuffix(name, "_max"); ok {
+			name, op = n, "max"
+		}
+		if !validation.ValidAttributeName(name) {
+			return nil, ad.ErrInvalidAttribute
+		}
+		if op != "eq" {
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+				return nil, ad.ErrInvalidAttribute
+			}
+		}
+
+		filters = append(filters, models.AttributeFilter{Name: name, Op: op, Value: value})
+	}
+	return filters, nil
+}
+
+func filterAttributes(filter models.Filter) map[string]string {
+	if len(filter.Attributes) == 0 {
+		return nil
+	}
+
+	attrs := make(map[string]string, len(filter.Attributes))
+	for _, attr := range filter.Attributes {
+		key := attr.Name
+		if attr.Op != "eq" {
+			key += "_" + attr.Op
+		}
+		attrs[key] = attr.Value
+	}
+	return attrs
+}
+
+func filterCategory(filter models.Filter) string {
+	if filter.CategoryId == uuid.Nil {
+		return ""
+	}
+	return filter.CategoryId.String()
+}
+
+func filterAuthor(filter models.Filter) string {
+	if filter.AuthorId != uuid.Nil {
+		return filter.AuthorId.String()
+	}
+	return filter.AuthorLogin
+}
+
+// setPaginationHeaders adds RFC 8288 Link headers for the neighbouring pages
+// and X-Next-Cursor for keyset pagination. In cursor mode there is no way
+// back, so only the next link is emitted. X-Next-Cursor is the only place
+// GetAds returns the cursor in, as its body is a bare array old clients
+// rely on; GetAdsPage also has it in the envelope.
+func setPaginationHeaders(w http.ResponseWriter, u *url.URL, filter models.Filter, list models.AdList) {
+	if list.NextCursor != "" {
+		w.Header().Set("X-Next-Cursor", list.NextCursor)
+	}
+
+	if list.HasNext {
+		q := u.Query()
+		if filter.Cursor != nil && list.NextCursor != "" {
+			q.Set("cursor", list.NextCursor)
+			q.Del("page")
+		} else {
+			q.Set("page", strconv.Itoa(filter.Page+1))
+		}
+		w.Header().Add("Link", pageLink(u, q, "next"))
+	}
+
+	if filter.Cursor == nil && filter.Page > 1 {
+		q := u.Query()
+		q.Set("page", strconv.Itoa(filter.Page-1))
+		w.Header().Add("Link", pageLink(u, q, "prev"))
+	}
+}
+
+func pageLink(u *url.URL, q url.Values, rel string) string {
+	link := url.URL{Path: u.Path, RawQuery: q.Encode()}
+	return "<" + link.String() + `>; rel="` + rel + `"`
+}
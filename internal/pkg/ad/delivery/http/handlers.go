@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
@@ -12,10 +14,12 @@ import (
 
 	"github.com/K1tten2005/go_vk_intern/internal/models"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 	"github.com/satori/uuid"
 )
@@ -23,10 +27,14 @@ import (
 type AdHandler struct {
 	uc     ad.AdUsecase
 	secret string
+	// viewerKey keys the hashes of addresses of anonymous viewers.
+	viewerKey []byte
+	// trustedProxies are the networks X-Forwarded-For is accepted from.
+	trustedProxies []*net.IPNet
 }
 
-func CreateAdHandler(uc ad.AdUsecase) *AdHandler {
-	return &AdHandler{uc: uc, secret: os.Getenv("JWT_SECRET")}
+func CreateAdHandler(uc ad.AdUsecase, viewerKey []byte, trustedProxies []*net.IPNet) *AdHandler {
+	return &AdHandler{uc: uc, secret: os.Getenv("JWT_SECRET"), viewerKey: viewerKey, trustedProxies: trustedProxies}
 }
 
 func (h *AdHandler) CreateAd(w http.ResponseWriter, r *http.Request) {
@@ -39,17 +47,51 @@ func (h *AdHandler) CreateAd(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tags, err := validation.NormalizeTags(req.Tags)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// uploaded images go first, image_url alone is a gallery of one image
+	// for older clients
+	imageURLs := req.Images
+	if len(imageURLs) == 0 && len(req.ImageIds) == 0 && req.ImageURL != "" {
+		imageURLs = []string{req.ImageURL}
+	}
+	images := make(models.AdImageList, 0, len(req.ImageIds)+len(imageURLs))
+	for _, imageId := range req.ImageIds {
+		images = append(images, uploadedImage(imageId))
+	}
+	for _, link := range imageURLs {
+		images = append(images, models.AdImage{URL: link})
+	}
+
 	adReq := models.Ad{
 		Title:       req.Title,
 		Description: req.Description,
-		ImageURL:    req.ImageURL,
-		Price:       int(req.Price * 100.0),
+		Images:      images,
+		Price:       int(req.Price),
+		Currency:    req.Currency,
+		CategoryId:  req.CategoryId,
+		Attributes:  req.Attributes,
+		Tags:        tags,
+		City:        strings.TrimSpace(req.City),
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		Status:      req.Status,
 	}
 	if err := validation.ValidateAd(adReq); err != nil {
 		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
 		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if err := validation.ValidateImages(images); err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	adReq.Sanitize()
 
 	userId, ok := jwtUtils.GetIdFromContext(r.Context())
@@ -69,27 +111,38 @@ func (h *AdHandler) CreateAd(w http.ResponseWriter, r *http.Request) {
 
 	advertisement, err := h.uc.CreateAd(r.Context(), adReq)
 	if err != nil {
-		switch err {
-		case ad.ErrCreatingAd:
-			logger.LogHandlerError(loggerVar, err, http.StatusInternalServerError)
-			sendErr.SendError(w, err.Error(), http.StatusInternalServerError)
-		default:
-			logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
-			sendErr.SendError(w, "unknown error", http.StatusInternalServerError)
-		}
+		sendAdError(w, loggerVar, err)
 		return
 	}
 
+	statusCode := http.StatusCreated
+	if advertisement.Status == models.AdStatusPendingMedia {
+		// remote images are checked in the background, the owner polls
+		// the result
+		statusCode = http.StatusAccepted
+		w.Header().Set("Location", "/api/ad/"+advertisement.Id.String()+"/verification")
+	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(statusCode)
 	adResp := models.AdResp{
 		Id:          advertisement.Id,
 		Title:       advertisement.Title,
 		Description: advertisement.Description,
-		Price:       float64(advertisement.Price) / 100.0,
+		Price:       models.Money(advertisement.Price),
+		Currency:    advertisement.Currency,
+		CategoryId:  advertisement.CategoryId,
 		ImageURL:    advertisement.ImageURL,
+		Images:      withThumbnails(advertisement.Images),
 		CreatedAt:   advertisement.CreatedAt,
 		AuthorLogin: advertisement.AuthorLogin,
+		Version:     advertisement.Version,
+		Status:      advertisement.Status,
+		ExpiresAt:   advertisement.ExpiresAt,
+		Attributes:  advertisement.Attributes,
+		Tags:        advertisement.Tags,
+		City:        advertisement.City,
+		Latitude:    advertisement.Latitude,
+		Longitude:   advertisement.Longitude,
 	}
 
 	data, err := json.Marshal(adResp)
@@ -99,98 +152,825 @@ func (h *AdHandler) CreateAd(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	w.Write(data)
-	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
 }
 
 func (h *AdHandler) GetAds(w http.ResponseWriter, r *http.Request) {
 	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
 
-	var userId uuid.UUID
-	authHeader := r.Header.Get("Authorization")
-	if strings.HasPrefix(authHeader, "Bearer ") {
-		token := strings.TrimPrefix(authHeader, "Bearer ")
-		userIdStr, ok := jwtUtils.GetIdFromJWT(token, h.secret)
-		if ok {
-			var err error
-			userId, err = uuid.FromString(userIdStr)
-			if err != nil {
-				userId = uuid.Nil 
+	userId := h.getUserIdFromHeader(r)
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter.UserId = userId
+
+	list, err := h.uc.GetAds(r.Context(), filter)
+	if err != nil {
+		sendAdsError(w, loggerVar, err)
+		return
+	}
+	h.uc.RecordViews(r.Context(), models.ViewKindImpression, h.viewer(r, userId), list.Ads)
+
+	resp := make(models.AdRespList, 0, len(list.Ads))
+	for _, ad := range list.Ads {
+		resp = append(resp, withDisplayPrice(toAdResp(ad, userId), ad, filter.Currency))
+	}
+
+	setPaginationHeaders(w, r.URL, filter, list)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+	}
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+
+}
+
+// GetAdsPage is the v2 version of GetAds which wraps the ads into an
+// envelope with the total count and paging metadata.
+func (h *AdHandler) GetAdsPage(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId := h.getUserIdFromHeader(r)
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter.UserId = userId
+
+	h.writeAdsPage(w, r, loggerVar, filter, true)
+}
+
+// GetUserAds is the public seller page: published ads of one user.
+func (h *AdHandler) GetUserAds(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	login := mux.Vars(r)["login"]
+	if !validation.ValidLogin(login) {
+		logger.LogHandlerError(loggerVar, errors.New("invalid login"), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid login", http.StatusBadRequest)
+		return
+	}
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter.UserId = h.getUserIdFromHeader(r)
+	filter.AuthorId = uuid.Nil
+	filter.AuthorLogin = login
+
+	h.writeAdsPage(w, r, loggerVar, filter, true)
+}
+
+// GetMyAds lists ads of the authenticated user in every status, including
+// drafts and expired ads. The status parameter narrows the list down to a
+// comma separated set of statuses.
+func (h *AdHandler) GetMyAds(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter.UserId = userId
+	filter.AuthorId = userId
+	filter.AuthorLogin = ""
+	filter.Statuses = ad.AllStatuses()
+
+	if param := r.URL.Query().Get("status"); param != "" {
+		filter.Statuses = filter.Statuses[:0]
+		for _, s := range strings.Split(param, ",") {
+			status := models.AdStatus(strings.TrimSpace(s))
+			if !ad.ValidStatus(status) {
+				logger.LogHandlerError(loggerVar, ad.ErrInvalidStatus, http.StatusBadRequest)
+				sendErr.SendError(w, ad.ErrInvalidStatus.Error(), http.StatusBadRequest)
+				return
 			}
+			filter.Statuses = append(filter.Statuses, status)
 		}
 	}
 
-	q := r.URL.Query()
-	page, _ := strconv.Atoi(q.Get("page"))
-	limit, _ := strconv.Atoi(q.Get("limit"))
-	sortBy := q.Get("sort_by")
-	order := q.Get("order")
-	priceMin, _ := strconv.Atoi(q.Get("price_min"))
-	priceMin *= 100
-	priceMax, _ := strconv.Atoi(q.Get("price_max"))
-	priceMax *= 100
+	h.writeAdsPage(w, r, loggerVar, filter, false)
+}
 
-	if priceMax <= 0 || priceMax > validation.MaxPrice {
-		priceMax = validation.MaxPrice
+// GetMyFavorites lists ads saved by the authenticated user. Ads hidden from
+// the public since then are left out.
+func (h *AdHandler) GetMyFavorites(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
 	}
-	if priceMin < 0 {
-		priceMin = 0
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
 	}
-	if priceMin > validation.MaxPrice {
-		priceMin = validation.MaxPrice
+	filter.UserId = userId
+	filter.FavoritesOf = userId
+	filter.Statuses = []models.AdStatus{models.AdStatusPublished, models.AdStatusReserved, models.AdStatusSold, models.AdStatusArchived}
+
+	h.writeAdsPage(w, r, loggerVar, filter, false)
+}
+
+// writeAdsPage loads one page of ads and sends it in the v2 envelope.
+// Public listings count the ads as impressions, lists of the user's own
+// ads and favorites do not.
+func (h *AdHandler) writeAdsPage(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger, filter models.Filter, public bool) {
+	list, err := h.uc.GetAdsPage(r.Context(), filter)
+	if err != nil {
+		sendAdsError(w, loggerVar, err)
+		return
 	}
-	if page <= 0 {
-		page = 1
+	if public {
+		h.uc.RecordViews(r.Context(), models.ViewKindImpression, h.viewer(r, filter.UserId), list.Ads)
 	}
-	if limit <= 0 || limit > 100 {
-		limit = 10
+
+	resp := models.AdPageResp{
+		Items:      make(models.AdRespList, 0, len(list.Ads)),
+		Total:      list.Total,
+		Page:       filter.Page,
+		Limit:      filter.Limit,
+		HasNext:    list.HasNext,
+		NextCursor: list.NextCursor,
+		Filter:     toFilterResp(filter),
 	}
-	if sortBy != "price" && sortBy != "created_at" {
-		sortBy = "created_at"
+	for _, ad := range list.Ads {
+		resp.Items = append(resp.Items, withDisplayPrice(toAdResp(ad, filter.UserId), ad, filter.Currency))
 	}
-	if order != "asc" && order != "desc" {
-		order = "asc"
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
 	}
 
-	filter := models.Filter{
-		Page:     page,
-		Limit:    limit,
-		SortBy:   sortBy,
-		Order:    order,
-		PriceMin: priceMin,
-		PriceMax: priceMax,
-		UserId:   userId,
+	setPaginationHeaders(w, r.URL, filter, list)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+// GetPopularTags returns the most used tags of published ads with their
+// counts, limit caps the list at 100.
+func (h *AdHandler) GetPopularTags(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
 	}
 
-	ads, err := h.uc.GetAds(r.Context(), filter)
+	tags, err := h.uc.GetPopularTags(r.Context(), limit)
 	if err != nil {
 		logger.LogHandlerError(loggerVar, err, http.StatusInternalServerError)
-		sendErr.SendError(w, "failed to load ads", http.StatusInternalServerError)
-		return
-	}
-
-	resp := make(models.AdRespList, 0, len(ads))
-	for _, ad := range ads {
-		isOwner := userId != uuid.Nil && ad.UserId == userId
-		resp = append(resp, models.AdResp{
-			Id:          ad.Id,
-			Title:       ad.Title,
-			Description: ad.Description,
-			Price:       float64(ad.Price) / 100,
-			ImageURL:    ad.ImageURL,
-			CreatedAt:   ad.CreatedAt,
-			AuthorLogin: ad.AuthorLogin,
-			IsOwner:     isOwner,
+		sendErr.SendError(w, "failed to load tags", http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(tags)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *AdHandler) GetAdById(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	userId := h.getUserIdFromHeader(r)
+
+	advertisement, err := h.uc.GetAdById(r.Context(), userId, id)
+	switch {
+	case errors.Is(err, ad.ErrAdNotFound):
+		sendAdError(w, loggerVar, err)
+		return
+	case err != nil:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "failed to load ad", http.StatusInternalServerError)
+		return
+	}
+	h.uc.RecordViews(r.Context(), models.ViewKindView, h.viewer(r, userId), []models.Ad{advertisement})
+
+	sendAd(w, loggerVar, toAdResp(advertisement, userId), http.StatusOK)
+}
+
+// GetPriceHistory lists the latest prices of an ad, the newest first. The
+// first price of the ad is the one it was created with.
+func (h *AdHandler) GetPriceHistory(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	history, err := h.uc.GetPriceHistory(r.Context(), h.getUserIdFromHeader(r), id)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	resp := make(models.PriceChangeRespList, 0, len(history))
+	for _, change := range history {
+		resp = append(resp, models.PriceChangeResp{
+			Price:     models.Money(change.Price),
+			Currency:  change.Currency,
+			ChangedAt: change.ChangedAt,
 		})
 	}
 
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *AdHandler) UpdateAd(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		logger.LogHandlerError(loggerVar, errors.New("missing If-Match header"), http.StatusPreconditionRequired)
+		sendErr.SendError(w, "missing If-Match header", http.StatusPreconditionRequired)
+		return
+	}
+	version, ok := parseETag(ifMatch)
+	if !ok {
+		logger.LogHandlerError(loggerVar, errors.New("invalid If-Match header"), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid If-Match header", http.StatusBadRequest)
+		return
+	}
+
+	var req models.AdPatchReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return
+	}
+
+	tags, err := validation.NormalizeTags(req.Tags)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	patch := models.AdPatch{
+		Title:       req.Title,
+		Description: req.Description,
+		ImageURL:    req.ImageURL,
+		Currency:    req.Currency,
+		CategoryId:  req.CategoryId,
+		Attributes:  req.Attributes,
+		Tags:        tags,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+	}
+	if req.City != nil {
+		city := strings.TrimSpace(*req.City)
+		patch.City = &city
+	}
+	if req.Price != nil {
+		price := int(*req.Price)
+		patch.Price = &price
+	}
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	advertisement, err := h.uc.UpdateAd(r.Context(), userId, id, version, patch)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	sendAd(w, loggerVar, toAdResp(advertisement, userId), http.StatusOK)
+}
+
+func (h *AdHandler) TransitionAd(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	var req models.AdTransitionReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return
+	}
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	advertisement, err := h.uc.TransitionAd(r.Context(), userId, id, req.Status)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	sendAd(w, loggerVar, toAdResp(advertisement, userId), http.StatusOK)
+}
+
+func (h *AdHandler) RenewAd(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	advertisement, err := h.uc.RenewAd(r.Context(), userId, id)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	sendAd(w, loggerVar, toAdResp(advertisement, userId), http.StatusOK)
+}
+
+// GetAdVerification reports the status of the check of remote images of an
+// ad and the reason if the ad was rejected.
+func (h *AdHandler) GetAdVerification(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	verification, err := h.uc.GetAdVerification(r.Context(), userId, id)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	data, err := json.Marshal(verification)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *AdHandler) DeleteAd(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.DeleteAd(r.Context(), userId, id); err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func (h *AdHandler) AddFavorite(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.AddFavorite(r.Context(), userId, id); err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func (h *AdHandler) RemoveFavorite(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.RemoveFavorite(r.Context(), userId, id); err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func (h *AdHandler) RestoreAd(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	advertisement, err := h.uc.RestoreAd(r.Context(), userId, id)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	sendAd(w, loggerVar, toAdResp(advertisement, userId), http.StatusOK)
+}
+
+// AddAdImage appends an image to the gallery of an ad. With is_cover the
+// new image also becomes the cover.
+func (h *AdHandler) AddAdImage(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	var req models.AdImageReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return
+	}
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	newImage := models.AdImage{URL: req.URL, IsCover: req.IsCover}
+	if req.ImageId != nil {
+		newImage = uploadedImage(*req.ImageId)
+		newImage.IsCover = req.IsCover
+	}
+
+	images, err := h.uc.AddAdImage(r.Context(), userId, id, newImage)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	sendImages(w, loggerVar, images, http.StatusCreated)
+}
+
+func (h *AdHandler) DeleteAdImage(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	imageId, err := uuid.FromString(mux.Vars(r)["imageId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing image id: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid image id", http.StatusBadRequest)
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.DeleteAdImage(r.Context(), userId, id, imageId); err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+// ReorderAdImages sets a new order of the gallery and optionally a new
+// cover.
+func (h *AdHandler) ReorderAdImages(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	var req models.AdImageOrderReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return
+	}
+	coverId := uuid.Nil
+	if req.CoverId != nil {
+		coverId = *req.CoverId
+	}
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	images, err := h.uc.ReorderAdImages(r.Context(), userId, id, req.ImageIds, coverId)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	sendImages(w, loggerVar, images, http.StatusOK)
+}
+
+// uploadedImage links an image uploaded to POST /api/images, its original
+// is served by the image handlers.
+func uploadedImage(imageId uuid.UUID) models.AdImage {
+	return models.AdImage{URL: image.URL(imageId, image.SizeOriginal), ImageId: &imageId}
+}
+
+// withThumbnails adds thumbnail URLs to uploaded images, remote ones are
+// served as is.
+func withThumbnails(images models.AdImageList) models.AdImageList {
+	for i := range images {
+		if images[i].ImageId != nil {
+			images[i].Thumbnails = image.ThumbnailURLs(*images[i].ImageId)
+		}
+	}
+	return images
+}
+
+func sendImages(w http.ResponseWriter, loggerVar *slog.Logger, images models.AdImageList, statusCode int) {
+	data, err := json.Marshal(withThumbnails(images))
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+func parseAdId(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (uuid.UUID, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing ad id: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid ad id", http.StatusBadRequest)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func getUserIdFromContext(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (uuid.UUID, bool) {
+	userId, ok := jwtUtils.GetIdFromContext(r.Context())
+	if !ok {
+		logger.LogHandlerError(loggerVar, errors.New("error while getting user id from context"), http.StatusInternalServerError)
+		sendErr.SendError(w, "server error", http.StatusInternalServerError)
+		return uuid.Nil, false
+	}
+	return userId, true
+}
+
+// sendAd writes a single ad together with its ETag.
+func sendAd(w http.ResponseWriter, loggerVar *slog.Logger, resp models.AdResp, statusCode int) {
 	data, err := json.Marshal(resp)
 	if err != nil {
 		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
 		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
 	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag(resp.Version))
+	w.WriteHeader(statusCode)
 	w.Write(data)
-	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+// sendAdError maps errors returned by the ad usecase to HTTP statuses.
+func sendAdError(w http.ResponseWriter, loggerVar *slog.Logger, err error) {
+	var (
+		statusCode      int
+		transitionError *ad.TransitionError
+	)
+	switch {
+	case errors.Is(err, ad.ErrInvalidAd), errors.Is(err, ad.ErrInvalidStatus), errors.Is(err, ad.ErrCategoryNotFound),
+		errors.Is(err, ad.ErrInvalidCategory),
+		errors.Is(err, ad.ErrTooManyImages), errors.Is(err, ad.ErrInvalidOrder), errors.Is(err, ad.ErrUnknownCurrency),
+		errors.Is(err, ad.ErrInvalidSearch), errors.Is(err, ad.ErrTooManySearches), errors.Is(err, ad.ErrInvalidPeriod),
+		errors.Is(err, ad.ErrInvalidPrice), errors.Is(err, ad.ErrInvalidTagsMode), errors.Is(err, ad.ErrInvalidLocation),
+		errors.Is(err, ad.ErrInvalidAttribute), errors.Is(err, ad.ErrInvalidTag):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, ad.ErrAdNotFound), errors.Is(err, ad.ErrImageNotFound), errors.Is(err, ad.ErrSearchNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, ad.ErrForbidden):
+		statusCode = http.StatusForbidden
+	case errors.Is(err, ad.ErrVersionMismatch):
+		statusCode = http.StatusPreconditionFailed
+	case errors.Is(err, ad.ErrAdNotDeleted), errors.As(err, &transitionError), errors.Is(err, ad.ErrLastImage), errors.Is(err, ad.ErrGalleryChanged):
+		statusCode = http.StatusConflict
+	case errors.Is(err, ad.ErrCreatingAd), errors.Is(err, ad.ErrUpdatingAd), errors.Is(err, ad.ErrDeletingAd),
+		errors.Is(err, ad.ErrSavingFavorite), errors.Is(err, ad.ErrSavingSearch):
+		statusCode = http.StatusInternalServerError
+	default:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "unknown error", http.StatusInternalServerError)
+		return
+	}
+
+	logger.LogHandlerError(loggerVar, err, statusCode)
+	sendErr.SendError(w, err.Error(), statusCode)
+}
+
+// sendAdsError reports a failed listing. Only an unknown display currency or
+// category is the client's fault.
+func sendAdsError(w http.ResponseWriter, loggerVar *slog.Logger, err error) {
+	if errors.Is(err, ad.ErrUnknownCurrency) || errors.Is(err, ad.ErrCategoryNotFound) {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	logger.LogHandlerError(loggerVar, err, http.StatusInternalServerError)
+	sendErr.SendError(w, "failed to load ads", http.StatusInternalServerError)
+}
+
+// etag builds a strong entity tag from the ad version.
+func etag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// parseETag extracts the ad version from an If-Match value. Weak tags are
+// accepted since the version is the only thing compared.
+func parseETag(value string) (int, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.Atoi(value[1 : len(value)-1])
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// getUserIdFromHeader returns the id of the caller if a valid bearer token
+// is present. Public routes use it to fill IsOwner, so a missing or broken
+// token is not an error and yields uuid.Nil.
+func (h *AdHandler) getUserIdFromHeader(r *http.Request) uuid.UUID {
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return uuid.Nil
+	}
+
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	userIdStr, ok := jwtUtils.GetIdFromJWT(token, h.secret)
+	if !ok {
+		return uuid.Nil
+	}
+
+	userId, err := uuid.FromString(userIdStr)
+	if err != nil {
+		return uuid.Nil
+	}
+	return userId
+}
+
+func toAdResp(ad models.Ad, userId uuid.UUID) models.AdResp {
+	resp := models.AdResp{
+		Id:          ad.Id,
+		Title:       ad.Title,
+		Description: ad.Description,
+		Price:       models.Money(ad.Price),
+		Currency:    ad.Currency,
+		CategoryId:  ad.CategoryId,
+		ImageURL:    ad.ImageURL,
+		Images:      withThumbnails(ad.Images),
+		CreatedAt:   ad.CreatedAt,
+		AuthorLogin: ad.AuthorLogin,
+		Version:     ad.Version,
+		Status:      ad.Status,
+		ExpiresAt:   ad.ExpiresAt,
+		Attributes:  ad.Attributes,
+		Tags:        ad.Tags,
+		City:        ad.City,
+		Latitude:    ad.Latitude,
+		Longitude:   ad.Longitude,
+		DistanceKm:  roundDistance(ad.DistanceKm),
+		IsOwner:     userId != uuid.Nil && ad.UserId == userId,
+	}
+	if ad.PreviousPrice != nil {
+		previous := models.Money(*ad.PreviousPrice)
+		resp.PriceDropped, resp.PreviousPrice = true, &previous
+	}
+	resp.FavoritesCount = ad.FavoritesCount
+	resp.IsFavorite = userId != uuid.Nil && ad.IsFavorite
+	return resp
+}
 
+// withDisplayPrice shows the price of ad converted to currency, the price set
+// by the seller goes to original_price. Nothing changes without a currency.
+func withDisplayPrice(resp models.AdResp, ad models.Ad, currency string) models.AdResp {
+	if currency == "" || ad.DisplayPrice == nil {
+		return resp
+	}
+	original := resp.Price
+	resp.OriginalPrice, resp.OriginalCurrency = &original, resp.Currency
+	resp.Price, resp.Currency = models.Money(*ad.DisplayPrice), currency
+	if resp.PriceDropped && ad.DisplayPreviousPrice != nil {
+		previous := models.Money(*ad.DisplayPreviousPrice)
+		resp.PreviousPrice = &previous
+	}
+	return resp
+}
+
+// roundDistance keeps ten meters precision, the rest is noise for a buyer.
+func roundDistance(km *float64) *float64 {
+	if km == nil {
+		return nil
+	}
+	rounded := math.Round(*km*100) / 100
+	return &rounded
 }
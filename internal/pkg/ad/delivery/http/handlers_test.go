@@ -0,0 +1,1578 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad/mocks"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/cursor"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAdById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.NewV4()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+
+	tests := []struct {
+		name             string
+		id               string
+		mockBehavior     func()
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:             "Invalid id",
+			id:               "not-a-uuid",
+			mockBehavior:     func() {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "invalid ad id",
+		},
+		{
+			name: "Ad not found",
+			id:   id.String(),
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetAdById(gomock.Any(), uuid.Nil, id).Return(models.Ad{}, ad.ErrAdNotFound)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: ad.ErrAdNotFound.Error(),
+		},
+		{
+			name: "Successful",
+			id:   id.String(),
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetAdById(gomock.Any(), uuid.Nil, id).Return(models.Ad{Id: id, Title: "Test ad", Price: 1999}, nil)
+				mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindView, gomock.Any(), []models.Ad{{Id: id, Title: "Test ad", Price: 1999}})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"price":19.99`,
+		},
+		{
+			name: "Unknown error",
+			id:   id.String(),
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetAdById(gomock.Any(), uuid.Nil, id).Return(models.Ad{}, fmt.Errorf("unknown error"))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: "failed to load ad",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/ad/"+tt.id, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+
+			tt.mockBehavior()
+
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAdById(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			body := rr.Body.String()
+			assert.Contains(t, body, tt.expectedResponse)
+		})
+	}
+}
+
+func TestUpdateAd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+
+	tests := []struct {
+		name             string
+		ifMatch          string
+		reqBody          string
+		mockBehavior     func()
+		expectedStatus   int
+		expectedResponse string
+		expectedETag     string
+	}{
+		{
+			name:             "Missing If-Match",
+			reqBody:          `{"title": "New title"}`,
+			mockBehavior:     func() {},
+			expectedStatus:   http.StatusPreconditionRequired,
+			expectedResponse: "missing If-Match header",
+		},
+		{
+			name:             "Invalid If-Match",
+			ifMatch:          "abc",
+			reqBody:          `{"title": "New title"}`,
+			mockBehavior:     func() {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "invalid If-Match header",
+		},
+		{
+			name:             "Invalid JSON format",
+			ifMatch:          `"1"`,
+			reqBody:          `{"title": "New title"`,
+			mockBehavior:     func() {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "incorrect request",
+		},
+		{
+			name:    "Not owner",
+			ifMatch: `"1"`,
+			reqBody: `{"title": "New title"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().UpdateAd(gomock.Any(), userId, id, 1, gomock.Any()).Return(models.Ad{}, ad.ErrForbidden)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: ad.ErrForbidden.Error(),
+		},
+		{
+			name:    "Stale version",
+			ifMatch: `W/"1"`,
+			reqBody: `{"title": "New title"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().UpdateAd(gomock.Any(), userId, id, 1, gomock.Any()).Return(models.Ad{}, ad.ErrVersionMismatch)
+			},
+			expectedStatus:   http.StatusPreconditionFailed,
+			expectedResponse: ad.ErrVersionMismatch.Error(),
+		},
+		{
+			name:    "Invalid merged ad",
+			ifMatch: `"1"`,
+			reqBody: `{"title": ""}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().UpdateAd(gomock.Any(), userId, id, 1, gomock.Any()).Return(models.Ad{}, fmt.Errorf("%w: invalid title", ad.ErrInvalidAd))
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "invalid title",
+		},
+		{
+			name:    "Successful",
+			ifMatch: `"1"`,
+			reqBody: `{"price": 19.5}`,
+			mockBehavior: func() {
+				price := 1950
+				mockUsecase.EXPECT().UpdateAd(gomock.Any(), userId, id, 1, models.AdPatch{Price: &price}).Return(models.Ad{Id: id, UserId: userId, Price: 1950, Version: 2}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"version":2`,
+			expectedETag:     `"2"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/ad/"+id.String(), bytes.NewBufferString(tt.reqBody))
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			tt.mockBehavior()
+
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.UpdateAd(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedETag, rr.Header().Get("ETag"))
+
+			body := rr.Body.String()
+			assert.Contains(t, body, tt.expectedResponse)
+		})
+	}
+}
+
+func TestDeleteAd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+
+	tests := []struct {
+		name             string
+		mockBehavior     func()
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Ad not found",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteAd(gomock.Any(), userId, id).Return(ad.ErrAdNotFound)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: ad.ErrAdNotFound.Error(),
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteAd(gomock.Any(), userId, id).Return(ad.ErrForbidden)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: ad.ErrForbidden.Error(),
+		},
+		{
+			name: "Successful",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteAd(gomock.Any(), userId, id).Return(nil)
+			},
+			expectedStatus:   http.StatusNoContent,
+			expectedResponse: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/ad/"+id.String(), nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+
+			tt.mockBehavior()
+
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.DeleteAd(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			body := rr.Body.String()
+			assert.Contains(t, body, tt.expectedResponse)
+		})
+	}
+}
+
+func TestRestoreAd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+
+	tests := []struct {
+		name             string
+		mockBehavior     func()
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Ad is not deleted",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().RestoreAd(gomock.Any(), userId, id).Return(models.Ad{}, ad.ErrAdNotDeleted)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: ad.ErrAdNotDeleted.Error(),
+		},
+		{
+			name: "Successful",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().RestoreAd(gomock.Any(), userId, id).Return(models.Ad{Id: id, UserId: userId, Version: 3}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"is_owner":true`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/ad/"+id.String()+"/restore", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+
+			tt.mockBehavior()
+
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.RestoreAd(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			body := rr.Body.String()
+			assert.Contains(t, body, tt.expectedResponse)
+		})
+	}
+}
+
+func TestTransitionAd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+
+	tests := []struct {
+		name             string
+		reqBody          string
+		mockBehavior     func()
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:             "Invalid JSON format",
+			reqBody:          `{"status": `,
+			mockBehavior:     func() {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "incorrect request",
+		},
+		{
+			name:    "Unknown status",
+			reqBody: `{"status": "deleted"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().TransitionAd(gomock.Any(), userId, id, models.AdStatus("deleted")).Return(models.Ad{}, ad.ErrInvalidStatus)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: ad.ErrInvalidStatus.Error(),
+		},
+		{
+			name:    "Forbidden transition",
+			reqBody: `{"status": "draft"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().TransitionAd(gomock.Any(), userId, id, models.AdStatusDraft).
+					Return(models.Ad{}, &ad.TransitionError{From: models.AdStatusSold, To: models.AdStatusDraft})
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: "cannot change ad status from sold to draft",
+		},
+		{
+			name:    "Successful",
+			reqBody: `{"status": "sold"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().TransitionAd(gomock.Any(), userId, id, models.AdStatusSold).
+					Return(models.Ad{Id: id, UserId: userId, Status: models.AdStatusSold, Version: 2}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"status":"sold"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/ad/"+id.String()+"/transition", bytes.NewBufferString(tt.reqBody))
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+
+			tt.mockBehavior()
+
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.TransitionAd(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+
+			body := rr.Body.String()
+			assert.Contains(t, body, tt.expectedResponse)
+		})
+	}
+}
+
+func TestGetAds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+	mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+	priceCursor, err := cursor.Encode(models.Cursor{SortBy: "price", Order: "desc", Price: 1999, Currency: models.BaseCurrency, Id: uuid.NewV4()})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedFilter func(*models.Filter)
+	}{
+		{
+			name:  "Defaults",
+			query: "",
+			expectedFilter: func(f *models.Filter) {
+				assert.Equal(t, 1, f.Page)
+				assert.Equal(t, 10, f.Limit)
+				assert.Equal(t, "created_at", f.SortBy)
+				assert.Equal(t, "asc", f.Order)
+				assert.Empty(t, f.Query)
+			},
+		},
+		{
+			name:  "Search by relevance",
+			query: "q=%D0%B2%D0%B5%D0%BB%D0%BE%D1%81%D0%B8%D0%BF%D0%B5%D0%B4&sort_by=relevance",
+			expectedFilter: func(f *models.Filter) {
+				assert.Equal(t, "велосипед", f.Query)
+				assert.Equal(t, "relevance", f.SortBy)
+				assert.Equal(t, "desc", f.Order)
+			},
+		},
+		{
+			name:  "Cursor overrides sorting",
+			query: "sort_by=created_at&order=asc&page=5&cursor=" + priceCursor,
+			expectedFilter: func(f *models.Filter) {
+				assert.Equal(t, "price", f.SortBy)
+				assert.Equal(t, "desc", f.Order)
+				if assert.NotNil(t, f.Cursor) {
+					assert.Equal(t, 1999, f.Cursor.Price)
+				}
+			},
+		},
+		{
+			name:  "Relevance without query",
+			query: "sort_by=relevance",
+			expectedFilter: func(f *models.Filter) {
+				assert.Equal(t, "created_at", f.SortBy)
+				assert.Equal(t, "asc", f.Order)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/ad?"+tt.query, nil)
+
+			mockUsecase.EXPECT().GetAds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+				tt.expectedFilter(&f)
+				return models.AdList{NextCursor: "next"}, nil
+			})
+
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAds(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "next", rr.Header().Get("X-Next-Cursor"))
+		})
+	}
+}
+
+func TestGetAdsInvalidCursor(t *testing.T) {
+	rubCursor, err := cursor.Encode(models.Cursor{SortBy: "price", Order: "asc", Price: 1999, Currency: models.BaseCurrency, Id: uuid.NewV4()})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"Malformed", "cursor=broken"},
+		{"Another currency", "currency=USD&cursor=" + rubCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/ad?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mocks.NewMockAdUsecase(ctrl)}
+
+			handler.GetAds(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), cursor.ErrInvalidCursor.Error())
+		})
+	}
+}
+
+func TestGetAdsPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+	mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+	mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).Return(models.AdList{
+		Ads:     []models.Ad{{Id: uuid.NewV4(), Price: 1000}},
+		HasNext: true,
+		Total:   21,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/ad?page=2&limit=10&price_min=5", nil)
+	rr := httptest.NewRecorder()
+	handler := &AdHandler{uc: mockUsecase}
+
+	handler.GetAdsPage(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	assert.Contains(t, body, `"total":21`)
+	assert.Contains(t, body, `"page":2`)
+	assert.Contains(t, body, `"has_next":true`)
+	assert.Contains(t, body, `"price_min":5`)
+
+	links := rr.Header().Values("Link")
+	assert.Equal(t, []string{
+		`</api/v2/ad?limit=10&page=3&price_min=5>; rel="next"`,
+		`</api/v2/ad?limit=10&page=1&price_min=5>; rel="prev"`,
+	}, links)
+}
+
+func TestGetAdsAuthor(t *testing.T) {
+	authorId := uuid.NewV4()
+
+	tests := []struct {
+		name          string
+		author        string
+		expectedId    uuid.UUID
+		expectedLogin string
+	}{
+		{name: "By id", author: authorId.String(), expectedId: authorId},
+		{name: "By login", author: "seller_1", expectedLogin: "seller_1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+			mockUsecase.EXPECT().GetAds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+				assert.Equal(t, tt.expectedId, f.AuthorId)
+				assert.Equal(t, tt.expectedLogin, f.AuthorLogin)
+				assert.Empty(t, f.Statuses)
+				return models.AdList{}, nil
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/ad?author="+tt.author, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAds(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+		})
+	}
+}
+
+func TestGetUserAds(t *testing.T) {
+	tests := []struct {
+		name           string
+		login          string
+		usecaseMocker  func(*mocks.MockAdUsecase)
+		expectedStatus int
+	}{
+		{
+			name:  "Success",
+			login: "seller_1",
+			usecaseMocker: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+					assert.Equal(t, "seller_1", f.AuthorLogin)
+					assert.Equal(t, uuid.Nil, f.AuthorId)
+					assert.Empty(t, f.Statuses)
+					return models.AdList{Ads: []models.Ad{{Id: uuid.NewV4()}}, Total: 1}, nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid login",
+			login:          "a",
+			usecaseMocker:  func(*mocks.MockAdUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+			tt.usecaseMocker(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/"+tt.login+"/ads?author="+uuid.NewV4().String(), nil)
+			req = mux.SetURLVars(req, map[string]string{"login": tt.login})
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetUserAds(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestGetMyAds(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name             string
+		query            string
+		expectedStatuses []models.AdStatus
+		expectedStatus   int
+	}{
+		{
+			name:             "All statuses",
+			query:            "",
+			expectedStatuses: ad.AllStatuses(),
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "Narrowed by status",
+			query:            "?status=draft,archived",
+			expectedStatuses: []models.AdStatus{models.AdStatusDraft, models.AdStatusArchived},
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:           "Unknown status",
+			query:          "?status=draft,lost",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			if tt.expectedStatus == http.StatusOK {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+					assert.Equal(t, userId, f.AuthorId)
+					assert.Equal(t, tt.expectedStatuses, f.Statuses)
+					return models.AdList{Ads: []models.Ad{{Id: uuid.NewV4(), UserId: userId}}, Total: 1}, nil
+				})
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/ads"+tt.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetMyAds(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Contains(t, rr.Body.String(), `"is_owner":true`)
+			}
+		})
+	}
+}
+
+func TestGetAdsCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	categoryId := uuid.NewV4()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+	mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+	mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+		assert.Equal(t, categoryId, f.CategoryId)
+		return models.AdList{Ads: []models.Ad{{Id: uuid.NewV4(), CategoryId: categoryId}}, Total: 1}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/ad?category="+categoryId.String(), nil)
+	rr := httptest.NewRecorder()
+	handler := &AdHandler{uc: mockUsecase}
+
+	handler.GetAdsPage(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"category":"`+categoryId.String()+`"`)
+	assert.Contains(t, rr.Body.String(), `"category_id":"`+categoryId.String()+`"`)
+}
+
+func TestGetAdsInvalidCategory(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		mockBehavior     func(*mocks.MockAdUsecase)
+		expectedResponse string
+	}{
+		{
+			name:             "Malformed",
+			query:            "?category=bikes",
+			mockBehavior:     func(*mocks.MockAdUsecase) {},
+			expectedResponse: ad.ErrInvalidCategory.Error(),
+		},
+		{
+			name:  "Unknown",
+			query: "?category=" + uuid.NewV4().String(),
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).Return(models.AdList{}, ad.ErrCategoryNotFound)
+			},
+			expectedResponse: ad.ErrCategoryNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			tt.mockBehavior(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/ad"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAdsPage(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedResponse)
+		})
+	}
+}
+
+func TestGetAdsAttributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+	mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+	mockUsecase.EXPECT().GetAds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+		assert.Equal(t, []models.AttributeFilter{
+			{Name: "rooms", Op: "eq", Value: "2"},
+			{Name: "year", Op: "max", Value: "2020"},
+			{Name: "year", Op: "min", Value: "2015"},
+		}, f.Attributes)
+		return models.AdList{}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/ad?attr.year_min=2015&attr.rooms=2&attr.year_max=2020&attr.empty=", nil)
+	rr := httptest.NewRecorder()
+	handler := &AdHandler{uc: mockUsecase}
+
+	handler.GetAds(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestGetAdsInvalidAttributes(t *testing.T) {
+	tooMany := make([]string, 0, 11)
+	for i := range 11 {
+		tooMany = append(tooMany, fmt.Sprintf("attr.a%d=1", i))
+	}
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "Non numeric bound", query: "?attr.area_min=big"},
+		{name: "Infinite bound", query: "?attr.area_max=Inf"},
+		{name: "Malformed name", query: "?attr.Bad=1"},
+		{name: "Too many filters", query: "?" + strings.Join(tooMany, "&")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/ad"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mocks.NewMockAdUsecase(ctrl)}
+
+			handler.GetAdsPage(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), ad.ErrInvalidAttribute.Error())
+		})
+	}
+}
+
+func TestGetAdsTags(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		expectedTags []string
+		expectedAll  bool
+		expectedMode string
+	}{
+		{
+			name:         "Any by default",
+			query:        "?tags=Торг,%23новый&tags=торг",
+			expectedTags: []string{"торг", "новый"},
+			expectedMode: `"tags_mode":"any"`,
+		},
+		{
+			name:         "All",
+			query:        "?tags=торг,новый&tags_mode=all",
+			expectedTags: []string{"торг", "новый"},
+			expectedAll:  true,
+			expectedMode: `"tags_mode":"all"`,
+		},
+		{
+			name:         "Empty items are ignored",
+			query:        "?tags=торг,,&tags=",
+			expectedTags: []string{"торг"},
+			expectedMode: `"tags_mode":"any"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+			mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+				assert.Equal(t, tt.expectedTags, f.Tags)
+				assert.Equal(t, tt.expectedAll, f.TagsAll)
+				return models.AdList{}, nil
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/ad"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAdsPage(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			if tt.expectedMode != "" {
+				assert.Contains(t, rr.Body.String(), tt.expectedMode)
+			} else {
+				assert.NotContains(t, rr.Body.String(), "tags_mode")
+			}
+		})
+	}
+}
+
+func TestGetAdsInvalidTags(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "Too short", query: "?tags=торг,a"},
+		{name: "Forbidden characters", query: "?tags=<b>"},
+		{name: "Too many tags", query: "?tags=t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/ad"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mocks.NewMockAdUsecase(ctrl)}
+
+			handler.GetAdsPage(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), ad.ErrInvalidTag.Error())
+		})
+	}
+}
+
+func TestGetAdsInvalidTagsMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/ad?tags=торг&tags_mode=every", nil)
+	rr := httptest.NewRecorder()
+	handler := &AdHandler{uc: mocks.NewMockAdUsecase(ctrl)}
+
+	handler.GetAdsPage(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), ad.ErrInvalidTagsMode.Error())
+}
+
+func TestGetPopularTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+	mockUsecase.EXPECT().GetPopularTags(gomock.Any(), 20).Return(models.TagCountList{{Tag: "торг", Count: 7}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags/popular?limit=1000", nil)
+	rr := httptest.NewRecorder()
+	handler := &AdHandler{uc: mockUsecase}
+
+	handler.GetPopularTags(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `[{"tag":"торг","count":7}]`, rr.Body.String())
+}
+
+func TestGetAdsLocation(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedFilter func(*models.Filter)
+	}{
+		{
+			name:  "Radius and distance sorting",
+			query: "lat=55.75&lon=37.62&radius_km=15&sort_by=distance",
+			expectedFilter: func(f *models.Filter) {
+				assert.Equal(t, &models.GeoPoint{Lat: 55.75, Lon: 37.62}, f.Location)
+				assert.Equal(t, 15.0, f.RadiusKm)
+				assert.Equal(t, "distance", f.SortBy)
+				assert.Equal(t, "asc", f.Order)
+			},
+		},
+		{
+			name:  "Radius is capped",
+			query: "lat=55.75&lon=37.62&radius_km=100000",
+			expectedFilter: func(f *models.Filter) {
+				assert.Equal(t, float64(validation.MaxRadiusKm), f.RadiusKm)
+			},
+		},
+		{
+			name:  "No location",
+			query: "radius_km=15&sort_by=distance",
+			expectedFilter: func(f *models.Filter) {
+				assert.Nil(t, f.Location)
+				assert.Zero(t, f.RadiusKm)
+				assert.Equal(t, "created_at", f.SortBy)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			distance := 3.14159
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+			mockUsecase.EXPECT().GetAds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+				tt.expectedFilter(&f)
+				return models.AdList{Ads: []models.Ad{{Id: uuid.NewV4(), City: "Москва", DistanceKm: &distance}}}, nil
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/ad?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAds(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Contains(t, rr.Body.String(), `"distance_km":3.14`)
+			assert.Contains(t, rr.Body.String(), `"city":"Москва"`)
+		})
+	}
+}
+
+func TestGetAdsInvalidLocation(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "Latitude only", query: "?lat=55.75&radius_km=15"},
+		{name: "Longitude only", query: "?lon=37.62"},
+		{name: "Malformed coordinates", query: "?lat=north&lon=37.62"},
+		{name: "Off the Earth", query: "?lat=95&lon=37.62"},
+		{name: "Malformed radius", query: "?lat=55.75&lon=37.62&radius_km=far"},
+		{name: "Negative radius", query: "?lat=55.75&lon=37.62&radius_km=-5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/ad"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mocks.NewMockAdUsecase(ctrl)}
+
+			handler.GetAdsPage(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), ad.ErrInvalidLocation.Error())
+		})
+	}
+}
+
+func TestDeleteAdImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.NewV4()
+	imageId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+
+	tests := []struct {
+		name             string
+		imageId          string
+		mockBehavior     func()
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:             "Invalid image id",
+			imageId:          "not-a-uuid",
+			mockBehavior:     func() {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "invalid image id",
+		},
+		{
+			name:    "Image not found",
+			imageId: imageId.String(),
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteAdImage(gomock.Any(), userId, id, imageId).Return(ad.ErrImageNotFound)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: ad.ErrImageNotFound.Error(),
+		},
+		{
+			name:    "Last image",
+			imageId: imageId.String(),
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteAdImage(gomock.Any(), userId, id, imageId).Return(ad.ErrLastImage)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: ad.ErrLastImage.Error(),
+		},
+		{
+			name:    "Successful",
+			imageId: imageId.String(),
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteAdImage(gomock.Any(), userId, id, imageId).Return(nil)
+			},
+			expectedStatus:   http.StatusNoContent,
+			expectedResponse: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/ad/"+id.String()+"/images/"+tt.imageId, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String(), "imageId": tt.imageId})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+
+			tt.mockBehavior()
+
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.DeleteAdImage(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedResponse)
+		})
+	}
+}
+
+func TestGetAdsPrice(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		expectedMin      int
+		expectedMax      int
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:             "Decimal bounds",
+			query:            "?price_min=19.99&price_max=100.5",
+			expectedMin:      1999,
+			expectedMax:      10050,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"price_min":19.99,"price_max":100.5`,
+		},
+		{
+			name:             "No bounds",
+			query:            "",
+			expectedMax:      0,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"price_min":0,"price_max":0`,
+		},
+		{
+			// prices converted to rubles may be above MaxPrice
+			name:             "Bounds above MaxPrice are kept",
+			query:            "?price_min=2000000&price_max=3000000",
+			expectedMin:      200000000,
+			expectedMax:      300000000,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"price_min":2000000,"price_max":3000000`,
+		},
+		{
+			name:  "No upper bound with currency",
+			query: "?currency=USD&price_min=2000000",
+			// converted prices may be above MaxPrice
+			expectedMin:    200000000,
+			expectedMax:    0,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:             "Malformed bounds",
+			query:            "?price_min=19.999&price_max=1e3",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: ad.ErrInvalidPrice.Error(),
+		},
+		{
+			name:             "Negative bound",
+			query:            "?price_min=-1",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: ad.ErrInvalidPrice.Error(),
+		},
+		{
+			name:             "Inverted bounds",
+			query:            "?price_min=200&price_max=100",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: ad.ErrInvalidPrice.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+			if tt.expectedStatus == http.StatusOK {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+					assert.Equal(t, tt.expectedMin, f.PriceMin)
+					assert.Equal(t, tt.expectedMax, f.PriceMax)
+					return models.AdList{}, nil
+				})
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/ad"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAdsPage(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedResponse)
+		})
+	}
+}
+
+func TestGetAdsCurrency(t *testing.T) {
+	displayPrice := 1081
+
+	tests := []struct {
+		name             string
+		query            string
+		mockBehavior     func(*mocks.MockAdUsecase)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:  "Converted",
+			query: "?currency=usd",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+					assert.Equal(t, "USD", f.Currency)
+					return models.AdList{Ads: []models.Ad{{Price: 100000, Currency: "RUB", DisplayPrice: &displayPrice}}}, nil
+				})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"price":10.81,"currency":"USD"`,
+		},
+		{
+			name:  "Original price is kept",
+			query: "?currency=USD",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).
+					Return(models.AdList{Ads: []models.Ad{{Price: 100000, Currency: "RUB", DisplayPrice: &displayPrice}}}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"original_price":1000,"original_currency":"RUB"`,
+		},
+		{
+			name:  "Unknown currency",
+			query: "?currency=XXX",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).Return(models.AdList{}, ad.ErrUnknownCurrency)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: ad.ErrUnknownCurrency.Error(),
+		},
+		{
+			name:  "Foreign ad above MaxPrice in rubles",
+			query: "",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+					assert.Equal(t, 0, f.PriceMax, "no upper bound is set by default")
+					basePrice := 185000000
+					return models.AdList{Ads: []models.Ad{{Price: 2000000, Currency: "USD", BasePrice: basePrice, DisplayPrice: &basePrice}}}, nil
+				})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"price":20000,"currency":"USD"`,
+		},
+		{
+			name:             "Malformed currency",
+			query:            "?currency=dollar",
+			mockBehavior:     func(*mocks.MockAdUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: ad.ErrUnknownCurrency.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			mockUsecase.EXPECT().RecordViews(gomock.Any(), models.ViewKindImpression, gomock.Any(), gomock.Any()).AnyTimes()
+			tt.mockBehavior(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/ad"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAdsPage(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedResponse)
+		})
+	}
+}
+
+func TestGetPriceHistory(t *testing.T) {
+	id := uuid.NewV4()
+	changedAt := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		mockBehavior     func(*mocks.MockAdUsecase)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Success",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetPriceHistory(gomock.Any(), uuid.Nil, id).
+					Return([]models.PriceChange{{Price: 1999, Currency: "RUB", ChangedAt: changedAt}}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `[{"price":19.99,"currency":"RUB","changed_at":"2025-07-01T12:00:00Z"}]`,
+		},
+		{
+			name: "Not found",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetPriceHistory(gomock.Any(), uuid.Nil, id).Return(nil, ad.ErrAdNotFound)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: ad.ErrAdNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			tt.mockBehavior(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/ad/"+id.String()+"/price-history", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetPriceHistory(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedResponse)
+		})
+	}
+}
+
+func TestPriceDropped(t *testing.T) {
+	previous, displayPrice, displayPrevious := 150000, 1081, 1622
+
+	resp := toAdResp(models.Ad{Price: 100000, Currency: "RUB"}, uuid.Nil)
+	assert.False(t, resp.PriceDropped)
+	assert.Nil(t, resp.PreviousPrice)
+
+	dropped := models.Ad{Price: 100000, Currency: "RUB", PreviousPrice: &previous, DisplayPrice: &displayPrice, DisplayPreviousPrice: &displayPrevious}
+	resp = toAdResp(dropped, uuid.Nil)
+	assert.True(t, resp.PriceDropped)
+	assert.Equal(t, models.Money(150000), *resp.PreviousPrice)
+
+	resp = withDisplayPrice(toAdResp(dropped, uuid.Nil), dropped, "USD")
+	assert.Equal(t, models.Money(1081), resp.Price)
+	assert.Equal(t, models.Money(1622), *resp.PreviousPrice)
+}
+
+func TestGetMyFavorites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userId := uuid.NewV4()
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+	mockUsecase.EXPECT().GetAdsPage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) (models.AdList, error) {
+		assert.Equal(t, userId, f.FavoritesOf)
+		assert.Equal(t, uuid.Nil, f.AuthorId)
+		assert.NotContains(t, f.Statuses, models.AdStatusDraft)
+		return models.AdList{Ads: []models.Ad{{Id: uuid.NewV4(), FavoritesCount: 3, IsFavorite: true}}, Total: 1}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/me/favorites", nil)
+	req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+	rr := httptest.NewRecorder()
+	handler := &AdHandler{uc: mockUsecase}
+
+	handler.GetMyFavorites(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"favorites_count":3`)
+	assert.Contains(t, rr.Body.String(), `"is_favorite":true`)
+}
+
+func TestAddFavorite(t *testing.T) {
+	userId := uuid.NewV4()
+	id := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{name: "Success", expectedStatus: http.StatusNoContent},
+		{name: "Not found", err: ad.ErrAdNotFound, expectedStatus: http.StatusNotFound},
+		{name: "Repo failure", err: ad.ErrSavingFavorite, expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			mockUsecase.EXPECT().AddFavorite(gomock.Any(), userId, id).Return(tt.err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/ad/"+id.String()+"/favorite", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.AddFavorite(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestIsFavorite(t *testing.T) {
+	favorite := models.Ad{FavoritesCount: 2, IsFavorite: true}
+
+	resp := toAdResp(favorite, uuid.NewV4())
+	assert.True(t, resp.IsFavorite)
+	assert.Equal(t, 2, resp.FavoritesCount)
+
+	// the counter is public, the flag is not
+	resp = toAdResp(favorite, uuid.Nil)
+	assert.False(t, resp.IsFavorite)
+	assert.Equal(t, 2, resp.FavoritesCount)
+}
+
+func TestSaveSearch(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedQuery  string
+		expectedStatus int
+	}{
+		{
+			name:           "Paging is not saved",
+			body:           `{"name": "Велосипеды", "query": "?q=велосипед&price_max=200&page=3&sort_by=price"}`,
+			expectedQuery:  "price_max=200&q=%D0%B2%D0%B5%D0%BB%D0%BE%D1%81%D0%B8%D0%BF%D0%B5%D0%B4",
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Only paging",
+			body:           `{"name": "Всё", "query": "page=2&limit=50"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Malformed currency",
+			body:           `{"name": "Доллары", "query": "currency=dollars"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Malformed JSON",
+			body:           `{"name": `,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			if tt.expectedStatus == http.StatusCreated {
+				mockUsecase.EXPECT().SaveSearch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s models.SavedSearch) (models.SavedSearch, error) {
+					assert.Equal(t, userId, s.UserId)
+					assert.Equal(t, tt.expectedQuery, s.Query)
+					assert.Equal(t, "велосипед", s.Filter.Query)
+					assert.Equal(t, 20000, s.Filter.PriceMax)
+					s.Id = uuid.NewV4()
+					return s, nil
+				})
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/me/searches", bytes.NewBufferString(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.SaveSearch(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusCreated {
+				assert.Contains(t, rr.Body.String(), `"price_max":200`)
+			}
+		})
+	}
+}
+
+func TestGetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userId := uuid.NewV4()
+	adId := uuid.NewV4()
+	mockUsecase := mocks.NewMockAdUsecase(ctrl)
+	mockUsecase.EXPECT().GetNotifications(gomock.Any(), userId, 2, 100).
+		Return([]models.Notification{{Id: uuid.NewV4(), SearchName: "Велосипеды", AdId: adId, AdTitle: "Велосипед"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/me/notifications?page=2&limit=1000", nil)
+	req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+	rr := httptest.NewRecorder()
+	handler := &AdHandler{uc: mockUsecase}
+
+	handler.GetNotifications(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"ad_id":"`+adId.String()+`"`)
+	assert.Contains(t, rr.Body.String(), `"search_name":"Велосипеды"`)
+}
+
+func TestGetAdStats(t *testing.T) {
+	userId := uuid.NewV4()
+	id := uuid.NewV4()
+	day := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		query            string
+		mockBehavior     func(*mocks.MockAdUsecase)
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:  "Success",
+			query: "?from=2025-07-01&to=2025-07-01",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdStats(gomock.Any(), userId, id, day, day).Return(models.AdStats{
+					From: day, To: day, Views: 3, Impressions: 10,
+					Days: []models.AdDayStats{{Day: day, Views: 3, Impressions: 10}},
+				}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"views":3,"impressions":10,"days":[{"date":"2025-07-01","views":3,"impressions":10}]`,
+		},
+		{
+			name: "Last 30 days by default",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdStats(gomock.Any(), userId, id, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, from, to time.Time) (models.AdStats, error) {
+						assert.Equal(t, to.AddDate(0, 0, -29), from)
+						return models.AdStats{From: from, To: to}, nil
+					})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"days":[]`,
+		},
+		{
+			name:             "Malformed date",
+			query:            "?from=01.07.2025",
+			mockBehavior:     func(*mocks.MockAdUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: ad.ErrInvalidPeriod.Error(),
+		},
+		{
+			name:  "Not the owner",
+			query: "?from=2025-07-01&to=2025-07-01",
+			mockBehavior: func(mockUsecase *mocks.MockAdUsecase) {
+				mockUsecase.EXPECT().GetAdStats(gomock.Any(), userId, id, day, day).Return(models.AdStats{}, ad.ErrForbidden)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: ad.ErrForbidden.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockAdUsecase(ctrl)
+			tt.mockBehavior(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/ad/"+id.String()+"/stats"+tt.query, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			rr := httptest.NewRecorder()
+			handler := &AdHandler{uc: mockUsecase}
+
+			handler.GetAdStats(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedResponse)
+		})
+	}
+}
+
+func TestViewer(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	assert.NoError(t, err)
+	handler := &AdHandler{viewerKey: []byte("key"), trustedProxies: []*net.IPNet{proxies}}
+	userId := uuid.NewV4()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/ads", nil)
+	assert.Equal(t, models.Viewer{UserId: userId, Key: "user:" + userId.String()}, handler.viewer(req, userId))
+
+	req.RemoteAddr = "10.0.0.1:5000"
+	req.Header.Set("User-Agent", "firefox")
+	anon := handler.viewer(req, uuid.Nil)
+	assert.Equal(t, uuid.Nil, anon.UserId)
+	assert.Contains(t, anon.Key, "anon:")
+	assert.NotContains(t, anon.Key, "10.0.0.1")
+
+	req.RemoteAddr = "10.0.0.1:6000"
+	assert.Equal(t, anon, handler.viewer(req, uuid.Nil), "the port changes between connections")
+
+	req.Header.Set("X-Forwarded-For", "192.168.1.1, 10.0.0.2")
+	forwarded := handler.viewer(req, uuid.Nil)
+	assert.NotEqual(t, anon, forwarded, "requests of a trusted proxy are told apart by X-Forwarded-For")
+
+	req.Header.Set("X-Forwarded-For", "172.16.0.1, 192.168.1.1, 10.0.0.2")
+	assert.Equal(t, forwarded, handler.viewer(req, uuid.Nil), "addresses added by the client are ignored")
+
+	req.RemoteAddr = "203.0.113.5:5000"
+	direct := handler.viewer(req, uuid.Nil)
+	req.Header.Del("X-Forwarded-For")
+	assert.Equal(t, direct, handler.viewer(req, uuid.Nil), "X-Forwarded-For of other clients is ignored")
+}
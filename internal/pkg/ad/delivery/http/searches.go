@@ -0,0 +1,200 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+// pagingParams choose a page of GetAds rather than the ads, they are not
+// saved with a search.
+var pagingParams = []string{"page", "limit", "cursor", "sort_by", "order"}
+
+// SaveSearch saves a GetAds query string under a name. A query without any
+// filter would match every new ad and is rejected.
+func (h *AdHandler) SaveSearch(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	var req models.SavedSearchReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return
+	}
+
+	query, err := url.ParseQuery(strings.TrimPrefix(strings.TrimSpace(req.Query), "?"))
+	for _, param := range pagingParams {
+		query.Del(param)
+	}
+	if err != nil || len(query) == 0 {
+		sendAdError(w, loggerVar, ad.ErrInvalidSearch)
+		return
+	}
+	filter, err := parseFilter(query)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	search, err := h.uc.SaveSearch(r.Context(), models.SavedSearch{
+		UserId: userId,
+		Name:   strings.TrimSpace(req.Name),
+		Query:  query.Encode(),
+		Filter: filter,
+	})
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	data, err := json.Marshal(toSavedSearchResp(search))
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+}
+
+func (h *AdHandler) GetSavedSearches(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	searches, err := h.uc.GetSavedSearches(r.Context(), userId)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	resp := make(models.SavedSearchRespList, 0, len(searches))
+	for _, search := range searches {
+		resp = append(resp, toSavedSearchResp(search))
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *AdHandler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing search id: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid search id", http.StatusBadRequest)
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.DeleteSavedSearch(r.Context(), userId, id); err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+// GetNotifications lists ads which matched saved searches of the user,
+// newest first. It takes the page and limit parameters of GetAds.
+func (h *AdHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	notifications, err := h.uc.GetNotifications(r.Context(), userId, page, limit)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	resp := make(models.NotificationRespList, 0, len(notifications))
+	for _, n := range notifications {
+		resp = append(resp, models.NotificationResp{
+			Id:         n.Id,
+			SearchId:   n.SearchId,
+			SearchName: n.SearchName,
+			AdId:       n.AdId,
+			AdTitle:    n.AdTitle,
+			CreatedAt:  n.CreatedAt,
+		})
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+// toSavedSearchResp shows the filter of a search the way GetAds reports the
+// one it used. Query was produced by SaveSearch, so it parses again.
+func toSavedSearchResp(search models.SavedSearch) models.SavedSearchResp {
+	query, _ := url.ParseQuery(search.Query)
+	filter, _ := parseFilter(query)
+	return models.SavedSearchResp{
+		Id:        search.Id,
+		Name:      search.Name,
+		Query:     search.Query,
+		Filter:    toFilterResp(filter),
+		CreatedAt: search.CreatedAt,
+	}
+}
@@ -0,0 +1,145 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
+	"github.com/satori/uuid"
+)
+
+const (
+	statsDateLayout = "2006-01-02"
+	// defaultStatsDays is the period of GetAdStats without from.
+	defaultStatsDays = 30
+)
+
+// viewer identifies who is asking for ads, for view counting. Signed in
+// users are told apart by their id, anonymous ones by their address and
+// browser. The address is only kept as a keyed hash.
+func (h *AdHandler) viewer(r *http.Request, userId uuid.UUID) models.Viewer {
+	if userId != uuid.Nil {
+		return models.Viewer{UserId: userId, Key: "user:" + userId.String()}
+	}
+
+	mac := hmac.New(sha256.New, h.viewerKey)
+	mac.Write([]byte(h.clientIP(r) + "\n" + r.UserAgent()))
+	return models.Viewer{Key: "anon:" + hex.EncodeToString(mac.Sum(nil)[:16])}
+}
+
+// clientIP returns the address of the client. X-Forwarded-For is only read
+// when the request comes from a trusted proxy, and then the nearest address
+// in it which is not a trusted proxy is taken: anything to the left of it
+// could have been written by the client itself.
+func (h *AdHandler) clientIP(r *http.Request) string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	if !h.trustedProxy(ip) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !h.trustedProxy(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func (h *AdHandler) trustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range h.trustedProxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAdStats shows the owner of an ad its daily views and impressions. The
+// period is given by from and to as UTC dates, the last 30 days by default.
+func (h *AdHandler) GetAdStats(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseAdId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	to := time.Now().UTC()
+	if param := r.URL.Query().Get("to"); param != "" {
+		var err error
+		if to, err = time.Parse(statsDateLayout, param); err != nil {
+			sendAdError(w, loggerVar, ad.ErrInvalidPeriod)
+			return
+		}
+	}
+	from := to.AddDate(0, 0, 1-defaultStatsDays)
+	if param := r.URL.Query().Get("from"); param != "" {
+		var err error
+		if from, err = time.Parse(statsDateLayout, param); err != nil {
+			sendAdError(w, loggerVar, ad.ErrInvalidPeriod)
+			return
+		}
+	}
+
+	stats, err := h.uc.GetAdStats(r.Context(), userId, id, from, to)
+	if err != nil {
+		sendAdError(w, loggerVar, err)
+		return
+	}
+
+	resp := models.AdStatsResp{
+		AdId:        id,
+		From:        stats.From.Format(statsDateLayout),
+		To:          stats.To.Format(statsDateLayout),
+		Views:       stats.Views,
+		Impressions: stats.Impressions,
+		Days:        make([]models.AdDayStatsResp, 0, len(stats.Days)),
+	}
+	for _, day := range stats.Days {
+		resp.Days = append(resp.Days, models.AdDayStatsResp{
+			Date:        day.Day.Format(statsDateLayout),
+			Views:       day.Views,
+			Impressions: day.Impressions,
+		})
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
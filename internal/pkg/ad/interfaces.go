@@ -3,20 +3,118 @@ package ad
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/fetcher"
+	"github.com/satori/uuid"
 )
 
 var (
-	ErrCreatingAd = errors.New("ad creation error")
+	ErrCreatingAd       = errors.New("ad creation error")
+	ErrAdNotFound       = errors.New("ad not found")
+	ErrUpdatingAd       = errors.New("ad update error")
+	ErrInvalidAd        = errors.New("invalid ad")
+	ErrForbidden        = errors.New("access denied")
+	ErrVersionMismatch  = errors.New("ad was modified by another request")
+	ErrDeletingAd       = errors.New("ad deletion error")
+	ErrAdNotDeleted     = errors.New("ad is not deleted")
+	ErrInvalidStatus    = errors.New("invalid ad status")
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrInvalidCategory  = errors.New("invalid category id")
+	ErrImageNotFound    = errors.New("image not found")
+	ErrTooManyImages    = errors.New("too many images")
+	ErrLastImage        = errors.New("ad must have at least one image")
+	ErrInvalidOrder     = errors.New("image order must list every image of the ad once")
+	ErrGalleryChanged   = errors.New("gallery was modified by another request")
+	ErrUnknownCurrency  = errors.New("unknown currency")
+	ErrSavingFavorite   = errors.New("favorites update error")
+	ErrInvalidSearch    = errors.New("invalid saved search: a name and a query string of GetAds expected")
+	ErrSearchNotFound   = errors.New("saved search not found")
+	ErrTooManySearches  = errors.New("too many saved searches")
+	ErrSavingSearch     = errors.New("saved search update error")
+	ErrInvalidPeriod    = errors.New("invalid stats period")
+	ErrInvalidTagsMode  = errors.New("invalid tags_mode: any or all expected")
+	ErrInvalidPrice     = errors.New("invalid price filter: price_min and price_max must be non-negative amounts, price_min not above price_max")
+	ErrInvalidLocation  = errors.New("invalid location filter: lat and lon must be given together and point on the Earth, radius_km must be a non-negative number")
+	ErrInvalidAttribute = errors.New("invalid attribute filter: at most 10 attr.* parameters with lower case names and numeric _min and _max bounds expected")
+	ErrInvalidTag       = errors.New("invalid tags filter: at most 10 tags of 2 to 30 letters, digits, spaces, hyphens and underscores expected")
 )
 
 type AdUsecase interface {
 	CreateAd(ctx context.Context, ad models.Ad) (models.Ad, error)
-	GetAds(ctx context.Context, filter models.Filter) ([]models.Ad, error)
+	GetAds(ctx context.Context, filter models.Filter) (models.AdList, error)
+	GetAdsPage(ctx context.Context, filter models.Filter) (models.AdList, error)
+	GetAdById(ctx context.Context, userId, id uuid.UUID) (models.Ad, error)
+	GetPriceHistory(ctx context.Context, userId, id uuid.UUID) ([]models.PriceChange, error)
+	AddFavorite(ctx context.Context, userId, id uuid.UUID) error
+	RemoveFavorite(ctx context.Context, userId, id uuid.UUID) error
+	UpdateAd(ctx context.Context, userId, id uuid.UUID, version int, patch models.AdPatch) (models.Ad, error)
+	TransitionAd(ctx context.Context, userId, id uuid.UUID, status models.AdStatus) (models.Ad, error)
+	RenewAd(ctx context.Context, userId, id uuid.UUID) (models.Ad, error)
+	DeleteAd(ctx context.Context, userId, id uuid.UUID) error
+	RestoreAd(ctx context.Context, userId, id uuid.UUID) (models.Ad, error)
+	PurgeDeletedAds(ctx context.Context, retention time.Duration) (int64, error)
+	ArchiveExpiredAds(ctx context.Context) (int64, error)
+	GetPopularTags(ctx context.Context, limit int) (models.TagCountList, error)
+	AddAdImage(ctx context.Context, userId, id uuid.UUID, image models.AdImage) (models.AdImageList, error)
+	DeleteAdImage(ctx context.Context, userId, id, imageId uuid.UUID) error
+	ReorderAdImages(ctx context.Context, userId, id uuid.UUID, imageIds []uuid.UUID, coverId uuid.UUID) (models.AdImageList, error)
+	GetAdVerification(ctx context.Context, userId, id uuid.UUID) (models.AdVerification, error)
+	VerifyPendingMedia(ctx context.Context, workers int) (int, error)
+	SaveSearch(ctx context.Context, search models.SavedSearch) (models.SavedSearch, error)
+	GetSavedSearches(ctx context.Context, userId uuid.UUID) ([]models.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userId, id uuid.UUID) error
+	GetNotifications(ctx context.Context, userId uuid.UUID, page, limit int) ([]models.Notification, error)
+	MatchSavedSearches(ctx context.Context) (int64, error)
+	RecordViews(ctx context.Context, kind models.ViewKind, viewer models.Viewer, ads []models.Ad)
+	FlushAdViews(ctx context.Context) (int, error)
+	PurgeAdViews(ctx context.Context) (int64, error)
+	GetAdStats(ctx context.Context, userId, id uuid.UUID, from, to time.Time) (models.AdStats, error)
 }
 
 type AdRepo interface {
 	InsertAd(ctx context.Context, ad models.Ad) error
 	SelectAds(ctx context.Context, filter models.Filter) ([]models.Ad, error)
+	CountAds(ctx context.Context, filter models.Filter) (int, error)
+	SelectAdById(ctx context.Context, id uuid.UUID) (models.Ad, error)
+	UpdateAd(ctx context.Context, ad models.Ad, version int) (models.Ad, error)
+	UpdateAdStatus(ctx context.Context, id uuid.UUID, from, to models.AdStatus, expiresAt time.Time) (int, error)
+	SelectAdOwner(ctx context.Context, id uuid.UUID) (uuid.UUID, bool, error)
+	SoftDeleteAd(ctx context.Context, id uuid.UUID) error
+	RestoreAd(ctx context.Context, id uuid.UUID) error
+	PurgeDeletedAds(ctx context.Context, deletedBefore time.Time) (int64, error)
+	ArchiveExpiredAds(ctx context.Context, expiredBefore time.Time, limit int) (int64, error)
+	SelectCategorySchema(ctx context.Context, categoryId uuid.UUID) (models.AttributeSchemaList, error)
+	SelectPopularTags(ctx context.Context, limit int) (models.TagCountList, error)
+	SelectAdImages(ctx context.Context, id uuid.UUID) (models.AdImageList, error)
+	InsertAdImage(ctx context.Context, id uuid.UUID, image models.AdImage, maxImages int) error
+	DeleteAdImage(ctx context.Context, id, imageId uuid.UUID) error
+	ReorderAdImages(ctx context.Context, id uuid.UUID, imageIds []uuid.UUID, coverId uuid.UUID) error
+	CountUserImages(ctx context.Context, userId uuid.UUID, imageIds []uuid.UUID) (int, error)
+	ClaimMediaChecks(ctx context.Context, limit int, leaseUntil time.Time) ([]models.MediaCheck, error)
+	CompleteMediaCheck(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	RetryMediaCheck(ctx context.Context, id uuid.UUID, reason string, nextAttempt time.Time) error
+	RejectMediaCheck(ctx context.Context, id uuid.UUID, reason string) (uuid.UUID, error)
+	SelectMediaCheck(ctx context.Context, id uuid.UUID) (models.MediaCheck, bool, error)
+	CurrencyExists(ctx context.Context, currency string) (bool, error)
+	SelectPriceHistory(ctx context.Context, id uuid.UUID, limit int) ([]models.PriceChange, error)
+	SelectFavorite(ctx context.Context, userId, id uuid.UUID) (bool, error)
+	InsertFavorite(ctx context.Context, userId, id uuid.UUID) error
+	DeleteFavorite(ctx context.Context, userId, id uuid.UUID) error
+	SelectFavoriteUsers(ctx context.Context, id, ownerId uuid.UUID) ([]uuid.UUID, error)
+	InsertSavedSearch(ctx context.Context, search models.SavedSearch, maxSearches int) (models.SavedSearch, error)
+	SelectSavedSearches(ctx context.Context, userId uuid.UUID) ([]models.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userId, id uuid.UUID) error
+	SelectNotifications(ctx context.Context, userId uuid.UUID, limit, offset int) ([]models.Notification, error)
+	MatchSavedSearches(ctx context.Context, limit int) (int, []models.Notification, error)
+	InsertAdViews(ctx context.Context, views []models.AdView, window time.Duration) error
+	PurgeAdViews(ctx context.Context, before time.Time) (int64, error)
+	SelectAdStats(ctx context.Context, id uuid.UUID, from, to time.Time) ([]models.AdDayStats, error)
+}
+
+// ImageFetcher downloads remote images of ads to check them, see
+// fetcher.Fetcher.
+type ImageFetcher interface {
+	Fetch(ctx context.Context, link string) (fetcher.Info, error)
 }
@@ -12,8 +12,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/K1tten2005/go_vk_intern/internal/models"
+	fetcher "github.com/K1tten2005/go_vk_intern/internal/pkg/utils/fetcher"
+	uuid "github.com/satori/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +44,50 @@ func (m *MockAdUsecase) EXPECT() *MockAdUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AddAdImage mocks base method.
+func (m *MockAdUsecase) AddAdImage(ctx context.Context, userId, id uuid.UUID, image models.AdImage) (models.AdImageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAdImage", ctx, userId, id, image)
+	ret0, _ := ret[0].(models.AdImageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAdImage indicates an expected call of AddAdImage.
+func (mr *MockAdUsecaseMockRecorder) AddAdImage(ctx, userId, id, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAdImage", reflect.TypeOf((*MockAdUsecase)(nil).AddAdImage), ctx, userId, id, image)
+}
+
+// AddFavorite mocks base method.
+func (m *MockAdUsecase) AddFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavorite", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavorite indicates an expected call of AddFavorite.
+func (mr *MockAdUsecaseMockRecorder) AddFavorite(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavorite", reflect.TypeOf((*MockAdUsecase)(nil).AddFavorite), ctx, userId, id)
+}
+
+// ArchiveExpiredAds mocks base method.
+func (m *MockAdUsecase) ArchiveExpiredAds(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveExpiredAds", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveExpiredAds indicates an expected call of ArchiveExpiredAds.
+func (mr *MockAdUsecaseMockRecorder) ArchiveExpiredAds(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveExpiredAds", reflect.TypeOf((*MockAdUsecase)(nil).ArchiveExpiredAds), ctx)
+}
+
 // CreateAd mocks base method.
 func (m *MockAdUsecase) CreateAd(ctx context.Context, ad models.Ad) (models.Ad, error) {
 	m.ctrl.T.Helper()
@@ -56,11 +103,113 @@ func (mr *MockAdUsecaseMockRecorder) CreateAd(ctx, ad any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAd", reflect.TypeOf((*MockAdUsecase)(nil).CreateAd), ctx, ad)
 }
 
+// DeleteAd mocks base method.
+func (m *MockAdUsecase) DeleteAd(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAd", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAd indicates an expected call of DeleteAd.
+func (mr *MockAdUsecaseMockRecorder) DeleteAd(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAd", reflect.TypeOf((*MockAdUsecase)(nil).DeleteAd), ctx, userId, id)
+}
+
+// DeleteAdImage mocks base method.
+func (m *MockAdUsecase) DeleteAdImage(ctx context.Context, userId, id, imageId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdImage", ctx, userId, id, imageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdImage indicates an expected call of DeleteAdImage.
+func (mr *MockAdUsecaseMockRecorder) DeleteAdImage(ctx, userId, id, imageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdImage", reflect.TypeOf((*MockAdUsecase)(nil).DeleteAdImage), ctx, userId, id, imageId)
+}
+
+// DeleteSavedSearch mocks base method.
+func (m *MockAdUsecase) DeleteSavedSearch(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedSearch", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedSearch indicates an expected call of DeleteSavedSearch.
+func (mr *MockAdUsecaseMockRecorder) DeleteSavedSearch(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedSearch", reflect.TypeOf((*MockAdUsecase)(nil).DeleteSavedSearch), ctx, userId, id)
+}
+
+// FlushAdViews mocks base method.
+func (m *MockAdUsecase) FlushAdViews(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushAdViews", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushAdViews indicates an expected call of FlushAdViews.
+func (mr *MockAdUsecaseMockRecorder) FlushAdViews(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushAdViews", reflect.TypeOf((*MockAdUsecase)(nil).FlushAdViews), ctx)
+}
+
+// GetAdById mocks base method.
+func (m *MockAdUsecase) GetAdById(ctx context.Context, userId, id uuid.UUID) (models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdById", ctx, userId, id)
+	ret0, _ := ret[0].(models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdById indicates an expected call of GetAdById.
+func (mr *MockAdUsecaseMockRecorder) GetAdById(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdById", reflect.TypeOf((*MockAdUsecase)(nil).GetAdById), ctx, userId, id)
+}
+
+// GetAdStats mocks base method.
+func (m *MockAdUsecase) GetAdStats(ctx context.Context, userId, id uuid.UUID, from, to time.Time) (models.AdStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdStats", ctx, userId, id, from, to)
+	ret0, _ := ret[0].(models.AdStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdStats indicates an expected call of GetAdStats.
+func (mr *MockAdUsecaseMockRecorder) GetAdStats(ctx, userId, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdStats", reflect.TypeOf((*MockAdUsecase)(nil).GetAdStats), ctx, userId, id, from, to)
+}
+
+// GetAdVerification mocks base method.
+func (m *MockAdUsecase) GetAdVerification(ctx context.Context, userId, id uuid.UUID) (models.AdVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdVerification", ctx, userId, id)
+	ret0, _ := ret[0].(models.AdVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdVerification indicates an expected call of GetAdVerification.
+func (mr *MockAdUsecaseMockRecorder) GetAdVerification(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdVerification", reflect.TypeOf((*MockAdUsecase)(nil).GetAdVerification), ctx, userId, id)
+}
+
 // GetAds mocks base method.
-func (m *MockAdUsecase) GetAds(ctx context.Context, filter models.Filter) ([]models.Ad, error) {
+func (m *MockAdUsecase) GetAds(ctx context.Context, filter models.Filter) (models.AdList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAds", ctx, filter)
-	ret0, _ := ret[0].([]models.Ad)
+	ret0, _ := ret[0].(models.AdList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -71,6 +220,257 @@ func (mr *MockAdUsecaseMockRecorder) GetAds(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAds", reflect.TypeOf((*MockAdUsecase)(nil).GetAds), ctx, filter)
 }
 
+// GetAdsPage mocks base method.
+func (m *MockAdUsecase) GetAdsPage(ctx context.Context, filter models.Filter) (models.AdList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdsPage", ctx, filter)
+	ret0, _ := ret[0].(models.AdList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdsPage indicates an expected call of GetAdsPage.
+func (mr *MockAdUsecaseMockRecorder) GetAdsPage(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdsPage", reflect.TypeOf((*MockAdUsecase)(nil).GetAdsPage), ctx, filter)
+}
+
+// GetNotifications mocks base method.
+func (m *MockAdUsecase) GetNotifications(ctx context.Context, userId uuid.UUID, page, limit int) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userId, page, limit)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockAdUsecaseMockRecorder) GetNotifications(ctx, userId, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockAdUsecase)(nil).GetNotifications), ctx, userId, page, limit)
+}
+
+// GetPopularTags mocks base method.
+func (m *MockAdUsecase) GetPopularTags(ctx context.Context, limit int) (models.TagCountList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPopularTags", ctx, limit)
+	ret0, _ := ret[0].(models.TagCountList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPopularTags indicates an expected call of GetPopularTags.
+func (mr *MockAdUsecaseMockRecorder) GetPopularTags(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularTags", reflect.TypeOf((*MockAdUsecase)(nil).GetPopularTags), ctx, limit)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockAdUsecase) GetPriceHistory(ctx context.Context, userId, id uuid.UUID) ([]models.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, userId, id)
+	ret0, _ := ret[0].([]models.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockAdUsecaseMockRecorder) GetPriceHistory(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockAdUsecase)(nil).GetPriceHistory), ctx, userId, id)
+}
+
+// GetSavedSearches mocks base method.
+func (m *MockAdUsecase) GetSavedSearches(ctx context.Context, userId uuid.UUID) ([]models.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedSearches", ctx, userId)
+	ret0, _ := ret[0].([]models.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedSearches indicates an expected call of GetSavedSearches.
+func (mr *MockAdUsecaseMockRecorder) GetSavedSearches(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedSearches", reflect.TypeOf((*MockAdUsecase)(nil).GetSavedSearches), ctx, userId)
+}
+
+// MatchSavedSearches mocks base method.
+func (m *MockAdUsecase) MatchSavedSearches(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchSavedSearches", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchSavedSearches indicates an expected call of MatchSavedSearches.
+func (mr *MockAdUsecaseMockRecorder) MatchSavedSearches(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchSavedSearches", reflect.TypeOf((*MockAdUsecase)(nil).MatchSavedSearches), ctx)
+}
+
+// PurgeAdViews mocks base method.
+func (m *MockAdUsecase) PurgeAdViews(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAdViews", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAdViews indicates an expected call of PurgeAdViews.
+func (mr *MockAdUsecaseMockRecorder) PurgeAdViews(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAdViews", reflect.TypeOf((*MockAdUsecase)(nil).PurgeAdViews), ctx)
+}
+
+// PurgeDeletedAds mocks base method.
+func (m *MockAdUsecase) PurgeDeletedAds(ctx context.Context, retention time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedAds", ctx, retention)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedAds indicates an expected call of PurgeDeletedAds.
+func (mr *MockAdUsecaseMockRecorder) PurgeDeletedAds(ctx, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedAds", reflect.TypeOf((*MockAdUsecase)(nil).PurgeDeletedAds), ctx, retention)
+}
+
+// RecordViews mocks base method.
+func (m *MockAdUsecase) RecordViews(ctx context.Context, kind models.ViewKind, viewer models.Viewer, ads []models.Ad) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordViews", ctx, kind, viewer, ads)
+}
+
+// RecordViews indicates an expected call of RecordViews.
+func (mr *MockAdUsecaseMockRecorder) RecordViews(ctx, kind, viewer, ads any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordViews", reflect.TypeOf((*MockAdUsecase)(nil).RecordViews), ctx, kind, viewer, ads)
+}
+
+// RemoveFavorite mocks base method.
+func (m *MockAdUsecase) RemoveFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavorite", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavorite indicates an expected call of RemoveFavorite.
+func (mr *MockAdUsecaseMockRecorder) RemoveFavorite(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavorite", reflect.TypeOf((*MockAdUsecase)(nil).RemoveFavorite), ctx, userId, id)
+}
+
+// RenewAd mocks base method.
+func (m *MockAdUsecase) RenewAd(ctx context.Context, userId, id uuid.UUID) (models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewAd", ctx, userId, id)
+	ret0, _ := ret[0].(models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewAd indicates an expected call of RenewAd.
+func (mr *MockAdUsecaseMockRecorder) RenewAd(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewAd", reflect.TypeOf((*MockAdUsecase)(nil).RenewAd), ctx, userId, id)
+}
+
+// ReorderAdImages mocks base method.
+func (m *MockAdUsecase) ReorderAdImages(ctx context.Context, userId, id uuid.UUID, imageIds []uuid.UUID, coverId uuid.UUID) (models.AdImageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderAdImages", ctx, userId, id, imageIds, coverId)
+	ret0, _ := ret[0].(models.AdImageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderAdImages indicates an expected call of ReorderAdImages.
+func (mr *MockAdUsecaseMockRecorder) ReorderAdImages(ctx, userId, id, imageIds, coverId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderAdImages", reflect.TypeOf((*MockAdUsecase)(nil).ReorderAdImages), ctx, userId, id, imageIds, coverId)
+}
+
+// RestoreAd mocks base method.
+func (m *MockAdUsecase) RestoreAd(ctx context.Context, userId, id uuid.UUID) (models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAd", ctx, userId, id)
+	ret0, _ := ret[0].(models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreAd indicates an expected call of RestoreAd.
+func (mr *MockAdUsecaseMockRecorder) RestoreAd(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAd", reflect.TypeOf((*MockAdUsecase)(nil).RestoreAd), ctx, userId, id)
+}
+
+// SaveSearch mocks base method.
+func (m *MockAdUsecase) SaveSearch(ctx context.Context, search models.SavedSearch) (models.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSearch", ctx, search)
+	ret0, _ := ret[0].(models.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSearch indicates an expected call of SaveSearch.
+func (mr *MockAdUsecaseMockRecorder) SaveSearch(ctx, search any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSearch", reflect.TypeOf((*MockAdUsecase)(nil).SaveSearch), ctx, search)
+}
+
+// TransitionAd mocks base method.
+func (m *MockAdUsecase) TransitionAd(ctx context.Context, userId, id uuid.UUID, status models.AdStatus) (models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionAd", ctx, userId, id, status)
+	ret0, _ := ret[0].(models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionAd indicates an expected call of TransitionAd.
+func (mr *MockAdUsecaseMockRecorder) TransitionAd(ctx, userId, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionAd", reflect.TypeOf((*MockAdUsecase)(nil).TransitionAd), ctx, userId, id, status)
+}
+
+// UpdateAd mocks base method.
+func (m *MockAdUsecase) UpdateAd(ctx context.Context, userId, id uuid.UUID, version int, patch models.AdPatch) (models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAd", ctx, userId, id, version, patch)
+	ret0, _ := ret[0].(models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAd indicates an expected call of UpdateAd.
+func (mr *MockAdUsecaseMockRecorder) UpdateAd(ctx, userId, id, version, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAd", reflect.TypeOf((*MockAdUsecase)(nil).UpdateAd), ctx, userId, id, version, patch)
+}
+
+// VerifyPendingMedia mocks base method.
+func (m *MockAdUsecase) VerifyPendingMedia(ctx context.Context, workers int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPendingMedia", ctx, workers)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPendingMedia indicates an expected call of VerifyPendingMedia.
+func (mr *MockAdUsecaseMockRecorder) VerifyPendingMedia(ctx, workers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPendingMedia", reflect.TypeOf((*MockAdUsecase)(nil).VerifyPendingMedia), ctx, workers)
+}
+
 // MockAdRepo is a mock of AdRepo interface.
 type MockAdRepo struct {
 	ctrl     *gomock.Controller
@@ -95,31 +495,588 @@ func (m *MockAdRepo) EXPECT() *MockAdRepoMockRecorder {
 	return m.recorder
 }
 
-// InsertAd mocks base method.
-func (m *MockAdRepo) InsertAd(ctx context.Context, ad models.Ad) error {
+// ArchiveExpiredAds mocks base method.
+func (m *MockAdRepo) ArchiveExpiredAds(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertAd", ctx, ad)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ArchiveExpiredAds", ctx, expiredBefore, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// InsertAd indicates an expected call of InsertAd.
-func (mr *MockAdRepoMockRecorder) InsertAd(ctx, ad any) *gomock.Call {
+// ArchiveExpiredAds indicates an expected call of ArchiveExpiredAds.
+func (mr *MockAdRepoMockRecorder) ArchiveExpiredAds(ctx, expiredBefore, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAd", reflect.TypeOf((*MockAdRepo)(nil).InsertAd), ctx, ad)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveExpiredAds", reflect.TypeOf((*MockAdRepo)(nil).ArchiveExpiredAds), ctx, expiredBefore, limit)
 }
 
-// SelectAds mocks base method.
-func (m *MockAdRepo) SelectAds(ctx context.Context, filter models.Filter) ([]models.Ad, error) {
+// ClaimMediaChecks mocks base method.
+func (m *MockAdRepo) ClaimMediaChecks(ctx context.Context, limit int, leaseUntil time.Time) ([]models.MediaCheck, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectAds", ctx, filter)
-	ret0, _ := ret[0].([]models.Ad)
+	ret := m.ctrl.Call(m, "ClaimMediaChecks", ctx, limit, leaseUntil)
+	ret0, _ := ret[0].([]models.MediaCheck)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SelectAds indicates an expected call of SelectAds.
-func (mr *MockAdRepoMockRecorder) SelectAds(ctx, filter any) *gomock.Call {
+// ClaimMediaChecks indicates an expected call of ClaimMediaChecks.
+func (mr *MockAdRepoMockRecorder) ClaimMediaChecks(ctx, limit, leaseUntil any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAds", reflect.TypeOf((*MockAdRepo)(nil).SelectAds), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimMediaChecks", reflect.TypeOf((*MockAdRepo)(nil).ClaimMediaChecks), ctx, limit, leaseUntil)
+}
+
+// CompleteMediaCheck mocks base method.
+func (m *MockAdRepo) CompleteMediaCheck(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMediaCheck", ctx, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteMediaCheck indicates an expected call of CompleteMediaCheck.
+func (mr *MockAdRepoMockRecorder) CompleteMediaCheck(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMediaCheck", reflect.TypeOf((*MockAdRepo)(nil).CompleteMediaCheck), ctx, id)
+}
+
+// CountAds mocks base method.
+func (m *MockAdRepo) CountAds(ctx context.Context, filter models.Filter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAds", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAds indicates an expected call of CountAds.
+func (mr *MockAdRepoMockRecorder) CountAds(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAds", reflect.TypeOf((*MockAdRepo)(nil).CountAds), ctx, filter)
+}
+
+// CountUserImages mocks base method.
+func (m *MockAdRepo) CountUserImages(ctx context.Context, userId uuid.UUID, imageIds []uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserImages", ctx, userId, imageIds)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserImages indicates an expected call of CountUserImages.
+func (mr *MockAdRepoMockRecorder) CountUserImages(ctx, userId, imageIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserImages", reflect.TypeOf((*MockAdRepo)(nil).CountUserImages), ctx, userId, imageIds)
+}
+
+// CurrencyExists mocks base method.
+func (m *MockAdRepo) CurrencyExists(ctx context.Context, currency string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrencyExists", ctx, currency)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrencyExists indicates an expected call of CurrencyExists.
+func (mr *MockAdRepoMockRecorder) CurrencyExists(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrencyExists", reflect.TypeOf((*MockAdRepo)(nil).CurrencyExists), ctx, currency)
+}
+
+// DeleteAdImage mocks base method.
+func (m *MockAdRepo) DeleteAdImage(ctx context.Context, id, imageId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdImage", ctx, id, imageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdImage indicates an expected call of DeleteAdImage.
+func (mr *MockAdRepoMockRecorder) DeleteAdImage(ctx, id, imageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdImage", reflect.TypeOf((*MockAdRepo)(nil).DeleteAdImage), ctx, id, imageId)
+}
+
+// DeleteFavorite mocks base method.
+func (m *MockAdRepo) DeleteFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFavorite", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFavorite indicates an expected call of DeleteFavorite.
+func (mr *MockAdRepoMockRecorder) DeleteFavorite(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavorite", reflect.TypeOf((*MockAdRepo)(nil).DeleteFavorite), ctx, userId, id)
+}
+
+// DeleteSavedSearch mocks base method.
+func (m *MockAdRepo) DeleteSavedSearch(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedSearch", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedSearch indicates an expected call of DeleteSavedSearch.
+func (mr *MockAdRepoMockRecorder) DeleteSavedSearch(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedSearch", reflect.TypeOf((*MockAdRepo)(nil).DeleteSavedSearch), ctx, userId, id)
+}
+
+// InsertAd mocks base method.
+func (m *MockAdRepo) InsertAd(ctx context.Context, ad models.Ad) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAd", ctx, ad)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAd indicates an expected call of InsertAd.
+func (mr *MockAdRepoMockRecorder) InsertAd(ctx, ad any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAd", reflect.TypeOf((*MockAdRepo)(nil).InsertAd), ctx, ad)
+}
+
+// InsertAdImage mocks base method.
+func (m *MockAdRepo) InsertAdImage(ctx context.Context, id uuid.UUID, image models.AdImage, maxImages int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAdImage", ctx, id, image, maxImages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAdImage indicates an expected call of InsertAdImage.
+func (mr *MockAdRepoMockRecorder) InsertAdImage(ctx, id, image, maxImages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAdImage", reflect.TypeOf((*MockAdRepo)(nil).InsertAdImage), ctx, id, image, maxImages)
+}
+
+// InsertAdViews mocks base method.
+func (m *MockAdRepo) InsertAdViews(ctx context.Context, views []models.AdView, window time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAdViews", ctx, views, window)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAdViews indicates an expected call of InsertAdViews.
+func (mr *MockAdRepoMockRecorder) InsertAdViews(ctx, views, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAdViews", reflect.TypeOf((*MockAdRepo)(nil).InsertAdViews), ctx, views, window)
+}
+
+// InsertFavorite mocks base method.
+func (m *MockAdRepo) InsertFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertFavorite", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertFavorite indicates an expected call of InsertFavorite.
+func (mr *MockAdRepoMockRecorder) InsertFavorite(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertFavorite", reflect.TypeOf((*MockAdRepo)(nil).InsertFavorite), ctx, userId, id)
+}
+
+// InsertSavedSearch mocks base method.
+func (m *MockAdRepo) InsertSavedSearch(ctx context.Context, search models.SavedSearch, maxSearches int) (models.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSavedSearch", ctx, search, maxSearches)
+	ret0, _ := ret[0].(models.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertSavedSearch indicates an expected call of InsertSavedSearch.
+func (mr *MockAdRepoMockRecorder) InsertSavedSearch(ctx, search, maxSearches any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSavedSearch", reflect.TypeOf((*MockAdRepo)(nil).InsertSavedSearch), ctx, search, maxSearches)
+}
+
+// MatchSavedSearches mocks base method.
+func (m *MockAdRepo) MatchSavedSearches(ctx context.Context, limit int) (int, []models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchSavedSearches", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].([]models.Notification)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MatchSavedSearches indicates an expected call of MatchSavedSearches.
+func (mr *MockAdRepoMockRecorder) MatchSavedSearches(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchSavedSearches", reflect.TypeOf((*MockAdRepo)(nil).MatchSavedSearches), ctx, limit)
+}
+
+// PurgeAdViews mocks base method.
+func (m *MockAdRepo) PurgeAdViews(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAdViews", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAdViews indicates an expected call of PurgeAdViews.
+func (mr *MockAdRepoMockRecorder) PurgeAdViews(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAdViews", reflect.TypeOf((*MockAdRepo)(nil).PurgeAdViews), ctx, before)
+}
+
+// PurgeDeletedAds mocks base method.
+func (m *MockAdRepo) PurgeDeletedAds(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedAds", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedAds indicates an expected call of PurgeDeletedAds.
+func (mr *MockAdRepoMockRecorder) PurgeDeletedAds(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedAds", reflect.TypeOf((*MockAdRepo)(nil).PurgeDeletedAds), ctx, deletedBefore)
+}
+
+// RejectMediaCheck mocks base method.
+func (m *MockAdRepo) RejectMediaCheck(ctx context.Context, id uuid.UUID, reason string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectMediaCheck", ctx, id, reason)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectMediaCheck indicates an expected call of RejectMediaCheck.
+func (mr *MockAdRepoMockRecorder) RejectMediaCheck(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectMediaCheck", reflect.TypeOf((*MockAdRepo)(nil).RejectMediaCheck), ctx, id, reason)
+}
+
+// ReorderAdImages mocks base method.
+func (m *MockAdRepo) ReorderAdImages(ctx context.Context, id uuid.UUID, imageIds []uuid.UUID, coverId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderAdImages", ctx, id, imageIds, coverId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderAdImages indicates an expected call of ReorderAdImages.
+func (mr *MockAdRepoMockRecorder) ReorderAdImages(ctx, id, imageIds, coverId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderAdImages", reflect.TypeOf((*MockAdRepo)(nil).ReorderAdImages), ctx, id, imageIds, coverId)
+}
+
+// RestoreAd mocks base method.
+func (m *MockAdRepo) RestoreAd(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAd", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAd indicates an expected call of RestoreAd.
+func (mr *MockAdRepoMockRecorder) RestoreAd(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAd", reflect.TypeOf((*MockAdRepo)(nil).RestoreAd), ctx, id)
+}
+
+// RetryMediaCheck mocks base method.
+func (m *MockAdRepo) RetryMediaCheck(ctx context.Context, id uuid.UUID, reason string, nextAttempt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryMediaCheck", ctx, id, reason, nextAttempt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryMediaCheck indicates an expected call of RetryMediaCheck.
+func (mr *MockAdRepoMockRecorder) RetryMediaCheck(ctx, id, reason, nextAttempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryMediaCheck", reflect.TypeOf((*MockAdRepo)(nil).RetryMediaCheck), ctx, id, reason, nextAttempt)
+}
+
+// SelectAdById mocks base method.
+func (m *MockAdRepo) SelectAdById(ctx context.Context, id uuid.UUID) (models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAdById", ctx, id)
+	ret0, _ := ret[0].(models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAdById indicates an expected call of SelectAdById.
+func (mr *MockAdRepoMockRecorder) SelectAdById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAdById", reflect.TypeOf((*MockAdRepo)(nil).SelectAdById), ctx, id)
+}
+
+// SelectAdImages mocks base method.
+func (m *MockAdRepo) SelectAdImages(ctx context.Context, id uuid.UUID) (models.AdImageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAdImages", ctx, id)
+	ret0, _ := ret[0].(models.AdImageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAdImages indicates an expected call of SelectAdImages.
+func (mr *MockAdRepoMockRecorder) SelectAdImages(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAdImages", reflect.TypeOf((*MockAdRepo)(nil).SelectAdImages), ctx, id)
+}
+
+// SelectAdOwner mocks base method.
+func (m *MockAdRepo) SelectAdOwner(ctx context.Context, id uuid.UUID) (uuid.UUID, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAdOwner", ctx, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectAdOwner indicates an expected call of SelectAdOwner.
+func (mr *MockAdRepoMockRecorder) SelectAdOwner(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAdOwner", reflect.TypeOf((*MockAdRepo)(nil).SelectAdOwner), ctx, id)
+}
+
+// SelectAdStats mocks base method.
+func (m *MockAdRepo) SelectAdStats(ctx context.Context, id uuid.UUID, from, to time.Time) ([]models.AdDayStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAdStats", ctx, id, from, to)
+	ret0, _ := ret[0].([]models.AdDayStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAdStats indicates an expected call of SelectAdStats.
+func (mr *MockAdRepoMockRecorder) SelectAdStats(ctx, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAdStats", reflect.TypeOf((*MockAdRepo)(nil).SelectAdStats), ctx, id, from, to)
+}
+
+// SelectAds mocks base method.
+func (m *MockAdRepo) SelectAds(ctx context.Context, filter models.Filter) ([]models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAds", ctx, filter)
+	ret0, _ := ret[0].([]models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAds indicates an expected call of SelectAds.
+func (mr *MockAdRepoMockRecorder) SelectAds(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAds", reflect.TypeOf((*MockAdRepo)(nil).SelectAds), ctx, filter)
+}
+
+// SelectCategorySchema mocks base method.
+func (m *MockAdRepo) SelectCategorySchema(ctx context.Context, categoryId uuid.UUID) (models.AttributeSchemaList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCategorySchema", ctx, categoryId)
+	ret0, _ := ret[0].(models.AttributeSchemaList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCategorySchema indicates an expected call of SelectCategorySchema.
+func (mr *MockAdRepoMockRecorder) SelectCategorySchema(ctx, categoryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCategorySchema", reflect.TypeOf((*MockAdRepo)(nil).SelectCategorySchema), ctx, categoryId)
+}
+
+// SelectFavorite mocks base method.
+func (m *MockAdRepo) SelectFavorite(ctx context.Context, userId, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFavorite", ctx, userId, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFavorite indicates an expected call of SelectFavorite.
+func (mr *MockAdRepoMockRecorder) SelectFavorite(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFavorite", reflect.TypeOf((*MockAdRepo)(nil).SelectFavorite), ctx, userId, id)
+}
+
+// SelectFavoriteUsers mocks base method.
+func (m *MockAdRepo) SelectFavoriteUsers(ctx context.Context, id, ownerId uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFavoriteUsers", ctx, id, ownerId)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFavoriteUsers indicates an expected call of SelectFavoriteUsers.
+func (mr *MockAdRepoMockRecorder) SelectFavoriteUsers(ctx, id, ownerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFavoriteUsers", reflect.TypeOf((*MockAdRepo)(nil).SelectFavoriteUsers), ctx, id, ownerId)
+}
+
+// SelectMediaCheck mocks base method.
+func (m *MockAdRepo) SelectMediaCheck(ctx context.Context, id uuid.UUID) (models.MediaCheck, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMediaCheck", ctx, id)
+	ret0, _ := ret[0].(models.MediaCheck)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectMediaCheck indicates an expected call of SelectMediaCheck.
+func (mr *MockAdRepoMockRecorder) SelectMediaCheck(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMediaCheck", reflect.TypeOf((*MockAdRepo)(nil).SelectMediaCheck), ctx, id)
+}
+
+// SelectNotifications mocks base method.
+func (m *MockAdRepo) SelectNotifications(ctx context.Context, userId uuid.UUID, limit, offset int) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectNotifications", ctx, userId, limit, offset)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectNotifications indicates an expected call of SelectNotifications.
+func (mr *MockAdRepoMockRecorder) SelectNotifications(ctx, userId, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectNotifications", reflect.TypeOf((*MockAdRepo)(nil).SelectNotifications), ctx, userId, limit, offset)
+}
+
+// SelectPopularTags mocks base method.
+func (m *MockAdRepo) SelectPopularTags(ctx context.Context, limit int) (models.TagCountList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectPopularTags", ctx, limit)
+	ret0, _ := ret[0].(models.TagCountList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectPopularTags indicates an expected call of SelectPopularTags.
+func (mr *MockAdRepoMockRecorder) SelectPopularTags(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectPopularTags", reflect.TypeOf((*MockAdRepo)(nil).SelectPopularTags), ctx, limit)
+}
+
+// SelectPriceHistory mocks base method.
+func (m *MockAdRepo) SelectPriceHistory(ctx context.Context, id uuid.UUID, limit int) ([]models.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectPriceHistory", ctx, id, limit)
+	ret0, _ := ret[0].([]models.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectPriceHistory indicates an expected call of SelectPriceHistory.
+func (mr *MockAdRepoMockRecorder) SelectPriceHistory(ctx, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectPriceHistory", reflect.TypeOf((*MockAdRepo)(nil).SelectPriceHistory), ctx, id, limit)
+}
+
+// SelectSavedSearches mocks base method.
+func (m *MockAdRepo) SelectSavedSearches(ctx context.Context, userId uuid.UUID) ([]models.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectSavedSearches", ctx, userId)
+	ret0, _ := ret[0].([]models.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectSavedSearches indicates an expected call of SelectSavedSearches.
+func (mr *MockAdRepoMockRecorder) SelectSavedSearches(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectSavedSearches", reflect.TypeOf((*MockAdRepo)(nil).SelectSavedSearches), ctx, userId)
+}
+
+// SoftDeleteAd mocks base method.
+func (m *MockAdRepo) SoftDeleteAd(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteAd", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteAd indicates an expected call of SoftDeleteAd.
+func (mr *MockAdRepoMockRecorder) SoftDeleteAd(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteAd", reflect.TypeOf((*MockAdRepo)(nil).SoftDeleteAd), ctx, id)
+}
+
+// UpdateAd mocks base method.
+func (m *MockAdRepo) UpdateAd(ctx context.Context, ad models.Ad, version int) (models.Ad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAd", ctx, ad, version)
+	ret0, _ := ret[0].(models.Ad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAd indicates an expected call of UpdateAd.
+func (mr *MockAdRepoMockRecorder) UpdateAd(ctx, ad, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAd", reflect.TypeOf((*MockAdRepo)(nil).UpdateAd), ctx, ad, version)
+}
+
+// UpdateAdStatus mocks base method.
+func (m *MockAdRepo) UpdateAdStatus(ctx context.Context, id uuid.UUID, from, to models.AdStatus, expiresAt time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAdStatus", ctx, id, from, to, expiresAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAdStatus indicates an expected call of UpdateAdStatus.
+func (mr *MockAdRepoMockRecorder) UpdateAdStatus(ctx, id, from, to, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAdStatus", reflect.TypeOf((*MockAdRepo)(nil).UpdateAdStatus), ctx, id, from, to, expiresAt)
+}
+
+// MockImageFetcher is a mock of ImageFetcher interface.
+type MockImageFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageFetcherMockRecorder
+	isgomock struct{}
+}
+
+// MockImageFetcherMockRecorder is the mock recorder for MockImageFetcher.
+type MockImageFetcherMockRecorder struct {
+	mock *MockImageFetcher
+}
+
+// NewMockImageFetcher creates a new mock instance.
+func NewMockImageFetcher(ctrl *gomock.Controller) *MockImageFetcher {
+	mock := &MockImageFetcher{ctrl: ctrl}
+	mock.recorder = &MockImageFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageFetcher) EXPECT() *MockImageFetcherMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *MockImageFetcher) Fetch(ctx context.Context, link string) (fetcher.Info, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, link)
+	ret0, _ := ret[0].(fetcher.Info)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockImageFetcherMockRecorder) Fetch(ctx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockImageFetcher)(nil).Fetch), ctx, link)
 }
@@ -3,13 +3,21 @@ package repo
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/K1tten2005/go_vk_intern/internal/models"
 	advertisement "github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
 )
 
 type AdRepo struct {
@@ -23,13 +31,325 @@ func CreateAdRepo(db pgxtype.Querier) *AdRepo {
 //go:embed sql/insertAd.sql
 var insertAd string
 
+//go:embed sql/adsFilter.sql
+var adsFilter string
+
 //go:embed sql/selectAds.sql
 var selectAds string
 
+//go:embed sql/countAds.sql
+var countAds string
+
+//go:embed sql/selectAdById.sql
+var selectAdById string
+
+//go:embed sql/updateAd.sql
+var updateAd string
+
+//go:embed sql/updateAdStatus.sql
+var updateAdStatus string
+
+//go:embed sql/archiveExpiredAds.sql
+var archiveExpiredAds string
+
+//go:embed sql/selectAdOwner.sql
+var selectAdOwner string
+
+//go:embed sql/softDeleteAd.sql
+var softDeleteAd string
+
+//go:embed sql/restoreAd.sql
+var restoreAd string
+
+//go:embed sql/purgeDeletedAds.sql
+var purgeDeletedAds string
+
+//go:embed sql/selectCategorySchema.sql
+var selectCategorySchema string
+
+//go:embed sql/selectPopularTags.sql
+var selectPopularTags string
+
+//go:embed sql/selectAdImages.sql
+var selectAdImages string
+
+//go:embed sql/insertAdImage.sql
+var insertAdImage string
+
+//go:embed sql/deleteAdImage.sql
+var deleteAdImage string
+
+//go:embed sql/reorderAdImages.sql
+var reorderAdImages string
+
+//go:embed sql/countUserImages.sql
+var countUserImages string
+
+//go:embed sql/claimMediaChecks.sql
+var claimMediaChecks string
+
+//go:embed sql/completeMediaCheck.sql
+var completeMediaCheck string
+
+//go:embed sql/retryMediaCheck.sql
+var retryMediaCheck string
+
+//go:embed sql/rejectMediaCheck.sql
+var rejectMediaCheck string
+
+//go:embed sql/selectMediaCheck.sql
+var selectMediaCheck string
+
+//go:embed sql/selectCurrencyExists.sql
+var selectCurrencyExists string
+
+//go:embed sql/selectPriceHistory.sql
+var selectPriceHistory string
+
+//go:embed sql/selectFavorite.sql
+var selectFavorite string
+
+//go:embed sql/selectFavoriteUsers.sql
+var selectFavoriteUsers string
+
+//go:embed sql/insertFavorite.sql
+var insertFavorite string
+
+//go:embed sql/deleteFavorite.sql
+var deleteFavorite string
+
+//go:embed sql/insertSavedSearch.sql
+var insertSavedSearch string
+
+//go:embed sql/selectSavedSearches.sql
+var selectSavedSearches string
+
+//go:embed sql/deleteSavedSearch.sql
+var deleteSavedSearch string
+
+//go:embed sql/selectNotifications.sql
+var selectNotifications string
+
+//go:embed sql/matchSavedSearches.sql
+var matchSavedSearches string
+
+//go:embed sql/insertAdViews.sql
+var insertAdViews string
+
+//go:embed sql/purgeAdViews.sql
+var purgeAdViews string
+
+//go:embed sql/selectAdStats.sql
+var selectAdStats string
+
+// sortColumns maps the sort_by values accepted by the handler to the
+// expressions used in ORDER BY of selectAds.
+var sortColumns = map[string]string{
+	// the rate is the same for every currency, so the order by the price
+	// in rubles is the order by the converted price
+	"price":      "a.base_price",
+	"price_drop": "price_drop(a.price, a.currency, a.previous_price, a.previous_currency)",
+	"created_at": "a.created_at",
+	"relevance":  "ts_rank(a.search_vector, websearch_to_tsquery('russian', $3) || websearch_to_tsquery('simple', $3))",
+	"distance":   "earth_distance_km(a.latitude, a.longitude, $10, $11)",
+}
+
+// kmPerDegree is the length of one degree of latitude on the sphere used by
+// earth_distance_km.
+const kmPerDegree = 6371.0088 * math.Pi / 180
+
+// boundingBox returns the latitude and longitude ranges containing every
+// point within radiusKm of p. The box lets the radius filter use the index
+// on the coordinates before computing exact distances. Near the poles and
+// across the antimeridian it falls back to the whole longitude range.
+func boundingBox(p models.GeoPoint, radiusKm float64) (latMin, latMax, lonMin, lonMax float64) {
+	delta := radiusKm / kmPerDegree
+	latMin, latMax = p.Lat-delta, p.Lat+delta
+	lonMin, lonMax = -180, 180
+	if latMin <= -90 || latMax >= 90 {
+		return math.Max(latMin, -90), math.Min(latMax, 90), lonMin, lonMax
+	}
+
+	lonDelta := delta / math.Cos(p.Lat*math.Pi/180)
+	if p.Lon-lonDelta >= -180 && p.Lon+lonDelta <= 180 {
+		lonMin, lonMax = p.Lon-lonDelta, p.Lon+lonDelta
+	}
+	return latMin, latMax, lonMin, lonMax
+}
+
+// filterArgs returns the arguments for the placeholders of adsFilter.sql.
+// Queries embedding the filter number their own placeholders after these.
+func filterArgs(filter models.Filter) []interface{} {
+	var authorId interface{}
+	if filter.AuthorId != uuid.Nil {
+		authorId = filter.AuthorId
+	}
+
+	statuses := make([]string, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
+	var categoryId interface{}
+	if filter.CategoryId != uuid.Nil {
+		categoryId = filter.CategoryId
+	}
+
+	// a NULL array would make the whole tags condition NULL
+	tags := filter.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	var lat, lon interface{}
+	var latMin, latMax, lonMin, lonMax float64
+	if filter.Location != nil {
+		lat, lon = filter.Location.Lat, filter.Location.Lon
+		latMin, latMax, lonMin, lonMax = boundingBox(*filter.Location, filter.RadiusKm)
+	}
+
+	// price bounds are given and prices are shown in rubles unless another
+	// currency is asked for
+	currency := filter.Currency
+	if currency == "" {
+		currency = models.BaseCurrency
+	}
+
+	var favoritesOf interface{}
+	if filter.FavoritesOf != uuid.Nil {
+		favoritesOf = filter.FavoritesOf
+	}
+
+	return []interface{}{
+		filter.PriceMin, filter.PriceMax, filter.Query, authorId, filter.AuthorLogin, statuses, categoryId, tags, filter.TagsAll,
+		lat, lon, filter.RadiusKm, latMin, latMax, lonMin, lonMax, currency, favoritesOf,
+	}
+}
+
+// filterQuery returns the WHERE condition shared by selectAds and countAds
+// with its arguments. Attribute filters are appended to adsFilter, both the
+// attribute name and the value are passed as arguments.
+func filterQuery(filter models.Filter) (string, []interface{}) {
+	args := filterArgs(filter)
+
+	var query strings.Builder
+	query.WriteString(adsFilter)
+	for _, attr := range filter.Attributes {
+		args = append(args, attr.Name)
+		name := len(args)
+
+		switch attr.Op {
+		case "eq":
+			// Values come as text, so the same value is also tried as a
+			// number and a boolean to match attributes of those types.
+			args = append(args, attr.Value)
+			fmt.Fprintf(&query, "\n    AND (a.attributes @> jsonb_build_object($%d::text, $%d::text)", name, len(args))
+			if number, err := strconv.ParseFloat(attr.Value, 64); err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+				args = append(args, number)
+				fmt.Fprintf(&query, " OR a.attributes @> jsonb_build_object($%d::text, $%d::numeric)", name, len(args))
+			}
+			if attr.Value == "true" || attr.Value == "false" {
+				args = append(args, attr.Value == "true")
+				fmt.Fprintf(&query, " OR a.attributes @> jsonb_build_object($%d::text, $%d::boolean)", name, len(args))
+			}
+			query.WriteString(")")
+		case "min", "max":
+			op := ">="
+			if attr.Op == "max" {
+				op = "<="
+			}
+			number, _ := strconv.ParseFloat(attr.Value, 64)
+			args = append(args, number)
+			fmt.Fprintf(&query, "\n    AND CASE WHEN jsonb_typeof(a.attributes -> $%[1]d::text) = 'number' THEN (a.attributes ->> $%[1]d::text)::numeric %[2]s $%[3]d ELSE false END", name, op, len(args))
+		}
+	}
+
+	return query.String(), args
+}
+
+// attributesArg keeps the NOT NULL column an object for ads without
+// attributes.
+func attributesArg(attrs map[string]interface{}) map[string]interface{} {
+	if attrs == nil {
+		return map[string]interface{}{}
+	}
+	return attrs
+}
+
+// categoryFkey is the constraint violated when an ad refers to a category
+// which does not exist.
+const categoryFkey = "ads_category_id_fkey"
+
+func isCategoryViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.ConstraintName == categoryFkey
+}
+
+const foreignKeyViolation = "23503"
+
+// currencyFkey is violated by an ad priced in a currency without an
+// exchange rate.
+const currencyFkey = "ads_currency_fkey"
+
+func isCurrencyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.ConstraintName == currencyFkey
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAd(row scanner, ad *models.Ad) error {
+	err := row.Scan(&ad.Id, &ad.UserId, &ad.CategoryId, &ad.Title, &ad.Description, &ad.Price, &ad.Currency, &ad.BasePrice, &ad.DisplayPrice, &ad.PreviousPrice, &ad.DisplayPreviousPrice, &ad.CreatedAt, &ad.AuthorLogin, &ad.Version, &ad.Status, &ad.ExpiresAt, &ad.Attributes, &ad.Tags, &ad.Images, &ad.City, &ad.Latitude, &ad.Longitude, &ad.DistanceKm, &ad.FavoritesCount, &ad.IsFavorite)
+	if cover, ok := ad.Images.Cover(); ok {
+		ad.ImageURL = cover.URL
+	}
+	return err
+}
+
+func uploadedArg(image models.AdImage) uuid.UUID {
+	if image.ImageId == nil {
+		return uuid.Nil
+	}
+	return *image.ImageId
+}
+
+// galleryChangedCodes are raised by the deferred checks of ad_images when
+// another request changed the gallery at the same time.
+var galleryChangedCodes = map[string]bool{
+	"23505": true, // unique_violation
+	"23P01": true, // exclusion_violation
+}
+
+func isGalleryConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && galleryChangedCodes[pgErr.Code]
+}
+
 func (repo *AdRepo) InsertAd(ctx context.Context, ad models.Ad) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	_, err := repo.db.Exec(ctx, insertAd, ad.Id, ad.UserId, ad.Title, ad.Description, ad.Price, ad.ImageURL, ad.CreatedAt)
+	ids := make([]uuid.UUID, 0, len(ad.Images))
+	urls := make([]string, 0, len(ad.Images))
+	// arrays of uuid can not hold NULL here, uuid.Nil stands for a remote
+	// image and is turned into NULL by the query
+	uploaded := make([]uuid.UUID, 0, len(ad.Images))
+	for _, image := range ad.Images {
+		ids = append(ids, image.Id)
+		urls = append(urls, image.URL)
+		uploaded = append(uploaded, uploadedArg(image))
+	}
+
+	_, err := repo.db.Exec(ctx, insertAd, ad.Id, ad.UserId, ad.CategoryId, ad.Title, ad.Description, ad.Price, ad.CreatedAt, ad.Status, ad.ExpiresAt, attributesArg(ad.Attributes), ad.City, ad.Latitude, ad.Longitude, ad.Tags, ids, urls, uploaded, ad.PendingStatus, ad.Currency)
+	if isCategoryViolation(err) {
+		loggerVar.Error(advertisement.ErrCategoryNotFound.Error())
+		return advertisement.ErrCategoryNotFound
+	}
+	if isCurrencyViolation(err) {
+		loggerVar.Error(advertisement.ErrUnknownCurrency.Error())
+		return advertisement.ErrUnknownCurrency
+	}
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return advertisement.ErrCreatingAd
@@ -41,11 +361,35 @@ func (repo *AdRepo) InsertAd(ctx context.Context, ad models.Ad) error {
 func (r *AdRepo) SelectAds(ctx context.Context, filter models.Filter) ([]models.Ad, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	query := fmt.Sprintf(selectAds, filter.SortBy, filter.Order)
+	cursorOp := ">"
+	if filter.Order == "desc" {
+		cursorOp = "<"
+	}
+	where, args := filterQuery(filter)
+	// The placeholders of selectAds follow the ones of the filter.
+	n := len(args)
+	query := fmt.Sprintf(selectAds, sortColumns[filter.SortBy], filter.Order, cursorOp, where, n+1, n+2, n+3, n+4, n+5)
 	ads := make([]models.Ad, 0, filter.Limit)
 
 	offset := (filter.Page - 1) * filter.Limit
-	rows, err := r.db.Query(ctx, query, filter.PriceMin, filter.PriceMax, filter.Limit, offset)
+	var cursorId, cursorKey interface{}
+	if filter.Cursor != nil {
+		offset = 0
+		cursorId = filter.Cursor.Id
+		cursorKey = filter.Cursor.CreatedAt
+		if filter.SortBy == "price" {
+			cursorKey = filter.Cursor.Price
+		}
+	}
+
+	// the viewer is only used for is_favorite
+	var viewer interface{}
+	if filter.UserId != uuid.Nil {
+		viewer = filter.UserId
+	}
+
+	args = append(args, filter.Limit, offset, cursorId, cursorKey, viewer)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		loggerVar.Error("query error: " + err.Error())
 		return ads, err
@@ -54,7 +398,7 @@ func (r *AdRepo) SelectAds(ctx context.Context, filter models.Filter) ([]models.
 
 	for rows.Next() {
 		var ad models.Ad
-		if err := rows.Scan(&ad.Id, &ad.UserId, &ad.Title, &ad.Description, &ad.Price, &ad.ImageURL, &ad.CreatedAt, &ad.AuthorLogin); err != nil {
+		if err := scanAd(rows, &ad); err != nil {
 			loggerVar.Error("scan error: " + err.Error())
 			return ads, err
 		}
@@ -62,3 +406,770 @@ func (r *AdRepo) SelectAds(ctx context.Context, filter models.Filter) ([]models.
 	}
 	return ads, nil
 }
+
+func (r *AdRepo) CountAds(ctx context.Context, filter models.Filter) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var total int
+	where, args := filterQuery(filter)
+	if err := r.db.QueryRow(ctx, fmt.Sprintf(countAds, where), args...).Scan(&total); err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *AdRepo) SelectAdById(ctx context.Context, id uuid.UUID) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var ad models.Ad
+	err := scanAd(r.db.QueryRow(ctx, selectAdById, id), &ad)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(advertisement.ErrAdNotFound.Error())
+		return models.Ad{}, advertisement.ErrAdNotFound
+	}
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return models.Ad{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return ad, nil
+}
+
+// UpdateAd saves ad if it is still at version. The saved ad is returned
+// with the new version and the prices the database keeps: the base price
+// and the previous price, which is converted when the currency changes.
+func (r *AdRepo) UpdateAd(ctx context.Context, ad models.Ad, version int) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	err := r.db.QueryRow(ctx, updateAd, ad.Id, version, ad.Title, ad.Description, ad.Price, ad.ImageURL, ad.CategoryId, attributesArg(ad.Attributes), ad.City, ad.Latitude, ad.Longitude, ad.Tags, ad.Currency).
+		Scan(&ad.Version, &ad.BasePrice, &ad.PreviousPrice)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(advertisement.ErrVersionMismatch.Error())
+		return models.Ad{}, advertisement.ErrVersionMismatch
+	}
+	if isCategoryViolation(err) {
+		loggerVar.Error(advertisement.ErrCategoryNotFound.Error())
+		return models.Ad{}, advertisement.ErrCategoryNotFound
+	}
+	if isCurrencyViolation(err) {
+		loggerVar.Error(advertisement.ErrUnknownCurrency.Error())
+		return models.Ad{}, advertisement.ErrUnknownCurrency
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, advertisement.ErrUpdatingAd
+	}
+
+	loggerVar.Info("Successful")
+	return ad, nil
+}
+
+// UpdateAdStatus moves an ad from one status to another. A draft being
+// published is queued for the saved search matcher, which dropped it while
+// it was a draft.
+func (r *AdRepo) UpdateAdStatus(ctx context.Context, id uuid.UUID, from, to models.AdStatus, expiresAt time.Time) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var newVersion int
+	err := r.db.QueryRow(ctx, updateAdStatus, id, from, to, expiresAt).Scan(&newVersion)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(advertisement.ErrVersionMismatch.Error())
+		return 0, advertisement.ErrVersionMismatch
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, advertisement.ErrUpdatingAd
+	}
+
+	loggerVar.Info("Successful")
+	return newVersion, nil
+}
+
+func (r *AdRepo) SelectAdOwner(ctx context.Context, id uuid.UUID) (uuid.UUID, bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var (
+		userId  uuid.UUID
+		deleted bool
+	)
+	err := r.db.QueryRow(ctx, selectAdOwner, id).Scan(&userId, &deleted)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(advertisement.ErrAdNotFound.Error())
+		return uuid.Nil, false, advertisement.ErrAdNotFound
+	}
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return uuid.Nil, false, err
+	}
+
+	return userId, deleted, nil
+}
+
+func (r *AdRepo) SoftDeleteAd(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, softDeleteAd, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrDeletingAd
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(advertisement.ErrAdNotFound.Error())
+		return advertisement.ErrAdNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (r *AdRepo) RestoreAd(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, restoreAd, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrUpdatingAd
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(advertisement.ErrAdNotDeleted.Error())
+		return advertisement.ErrAdNotDeleted
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (r *AdRepo) PurgeDeletedAds(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, purgeDeletedAds, deletedBefore)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, advertisement.ErrDeletingAd
+	}
+
+	loggerVar.Info("Successful", slog.Int64("purged", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
+
+func (r *AdRepo) ArchiveExpiredAds(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, archiveExpiredAds, expiredBefore, limit)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, advertisement.ErrUpdatingAd
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// SelectCategorySchema returns the attribute schema of a category with the
+// attributes inherited from its ancestors.
+func (r *AdRepo) SelectCategorySchema(ctx context.Context, categoryId uuid.UUID) (models.AttributeSchemaList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectCategorySchema, categoryId)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		schema models.AttributeSchemaList
+		found  bool
+	)
+	for rows.Next() {
+		var own models.AttributeSchemaList
+		if err := rows.Scan(&own); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		schema = schema.Extend(own)
+		found = true
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	if !found {
+		loggerVar.Error(advertisement.ErrCategoryNotFound.Error())
+		return nil, advertisement.ErrCategoryNotFound
+	}
+
+	return schema, nil
+}
+
+// SelectPopularTags returns the tags used by most of the publicly visible
+// ads.
+func (r *AdRepo) SelectPopularTags(ctx context.Context, limit int) (models.TagCountList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectPopularTags, limit)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make(models.TagCountList, 0, limit)
+	for rows.Next() {
+		var tag models.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func (r *AdRepo) SelectAdImages(ctx context.Context, id uuid.UUID) (models.AdImageList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectAdImages, id)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	images := make(models.AdImageList, 0)
+	for rows.Next() {
+		var (
+			image   models.AdImage
+			imageId uuid.NullUUID
+		)
+		if err := rows.Scan(&image.Id, &image.URL, &imageId, &image.Position, &image.IsCover); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		if imageId.Valid {
+			image.ImageId = &imageId.UUID
+		}
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+// InsertAdImage appends an image to the end of the gallery. The first image
+// always becomes the cover, a later one only when asked to.
+func (r *AdRepo) InsertAdImage(ctx context.Context, id uuid.UUID, image models.AdImage, maxImages int) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var uploaded interface{}
+	if image.ImageId != nil {
+		uploaded = *image.ImageId
+	}
+
+	tag, err := r.db.Exec(ctx, insertAdImage, image.Id, id, image.URL, image.IsCover, maxImages, uploaded)
+	if isGalleryConflict(err) {
+		loggerVar.Error(advertisement.ErrGalleryChanged.Error())
+		return advertisement.ErrGalleryChanged
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrUpdatingAd
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(advertisement.ErrTooManyImages.Error())
+		return advertisement.ErrTooManyImages
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// DeleteAdImage removes an image and closes the gap in positions. When the
+// cover is removed, the first of the remaining images becomes the cover. The
+// last image is kept and ErrLastImage is returned, the check and the removal
+// are one statement so concurrent requests can not empty the gallery.
+func (r *AdRepo) DeleteAdImage(ctx context.Context, id, imageId uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var found, deleted bool
+	err := r.db.QueryRow(ctx, deleteAdImage, id, imageId).Scan(&found, &deleted)
+	if isGalleryConflict(err) {
+		loggerVar.Error(advertisement.ErrGalleryChanged.Error())
+		return advertisement.ErrGalleryChanged
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrUpdatingAd
+	}
+	if !found {
+		loggerVar.Error(advertisement.ErrImageNotFound.Error())
+		return advertisement.ErrImageNotFound
+	}
+	if !deleted {
+		loggerVar.Error(advertisement.ErrLastImage.Error())
+		return advertisement.ErrLastImage
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// ReorderAdImages puts the images into the order of imageIds and makes
+// coverId the cover. imageIds must list the whole gallery.
+func (r *AdRepo) ReorderAdImages(ctx context.Context, id uuid.UUID, imageIds []uuid.UUID, coverId uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, reorderAdImages, id, imageIds, coverId)
+	if isGalleryConflict(err) {
+		loggerVar.Error(advertisement.ErrGalleryChanged.Error())
+		return advertisement.ErrGalleryChanged
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrUpdatingAd
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// CountUserImages counts how many of imageIds are images uploaded by userId.
+func (r *AdRepo) CountUserImages(ctx context.Context, userId uuid.UUID, imageIds []uuid.UUID) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var count int
+	if err := r.db.QueryRow(ctx, countUserImages, userId, imageIds).Scan(&count); err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return 0, err
+	}
+	return count, nil
+}
+
+// ClaimMediaChecks leases up to limit pending image checks until leaseUntil.
+// A check whose worker died is claimed again once the lease is over.
+func (r *AdRepo) ClaimMediaChecks(ctx context.Context, limit int, leaseUntil time.Time) ([]models.MediaCheck, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, claimMediaChecks, limit, leaseUntil)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	checks := make([]models.MediaCheck, 0, limit)
+	for rows.Next() {
+		var check models.MediaCheck
+		if err := rows.Scan(&check.AdId, &check.TargetStatus, &check.Attempts); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+	return checks, rows.Err()
+}
+
+// CompleteMediaCheck gives an ad the status it was created with and returns
+// its owner. uuid.Nil is returned if the check was finished by someone else
+// or the ad left pending_media in the meantime.
+func (r *AdRepo) CompleteMediaCheck(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var ownerId uuid.UUID
+	err := r.db.QueryRow(ctx, completeMediaCheck, id).Scan(&ownerId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return uuid.Nil, advertisement.ErrUpdatingAd
+	}
+
+	loggerVar.Info("Successful")
+	return ownerId, nil
+}
+
+func (r *AdRepo) RetryMediaCheck(ctx context.Context, id uuid.UUID, reason string, nextAttempt time.Time) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := r.db.Exec(ctx, retryMediaCheck, id, reason, nextAttempt); err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrUpdatingAd
+	}
+	return nil
+}
+
+// RejectMediaCheck rejects an ad keeping the reason for its owner and
+// returns the owner, or uuid.Nil like CompleteMediaCheck.
+func (r *AdRepo) RejectMediaCheck(ctx context.Context, id uuid.UUID, reason string) (uuid.UUID, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var ownerId uuid.UUID
+	err := r.db.QueryRow(ctx, rejectMediaCheck, id, reason).Scan(&ownerId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return uuid.Nil, advertisement.ErrUpdatingAd
+	}
+
+	loggerVar.Info("Successful")
+	return ownerId, nil
+}
+
+// SelectMediaCheck returns the image check of an ad. Checks of verified ads
+// are removed, so false is returned for them.
+func (r *AdRepo) SelectMediaCheck(ctx context.Context, id uuid.UUID) (models.MediaCheck, bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	check := models.MediaCheck{AdId: id}
+	err := r.db.QueryRow(ctx, selectMediaCheck, id).Scan(&check.TargetStatus, &check.Rejected, &check.Reason, &check.Attempts, &check.CheckedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.MediaCheck{}, false, nil
+	}
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return models.MediaCheck{}, false, err
+	}
+	return check, true, nil
+}
+
+// CurrencyExists reports whether currency has an exchange rate, so prices
+// can be converted to it.
+func (r *AdRepo) CurrencyExists(ctx context.Context, currency string) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, selectCurrencyExists, currency).Scan(&exists); err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return false, err
+	}
+	return exists, nil
+}
+
+// SelectPriceHistory returns up to limit latest prices of an ad, the newest
+// first.
+func (r *AdRepo) SelectPriceHistory(ctx context.Context, id uuid.UUID, limit int) ([]models.PriceChange, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectPriceHistory, id, limit)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]models.PriceChange, 0)
+	for rows.Next() {
+		var change models.PriceChange
+		if err := rows.Scan(&change.Price, &change.Currency, &change.ChangedAt); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		history = append(history, change)
+	}
+	return history, nil
+}
+
+func (r *AdRepo) SelectFavorite(ctx context.Context, userId, id uuid.UUID) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var favorite bool
+	if err := r.db.QueryRow(ctx, selectFavorite, userId, id).Scan(&favorite); err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return false, err
+	}
+	return favorite, nil
+}
+
+func (r *AdRepo) InsertFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, insertFavorite, userId, id)
+	var pgErr *pgconn.PgError
+	// the ad was purged right after it was checked
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		loggerVar.Error(advertisement.ErrAdNotFound.Error())
+		return advertisement.ErrAdNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrSavingFavorite
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (r *AdRepo) DeleteFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := r.db.Exec(ctx, deleteFavorite, userId, id); err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrSavingFavorite
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// SelectFavoriteUsers returns the users who saved an ad, except its owner.
+func (r *AdRepo) SelectFavoriteUsers(ctx context.Context, id, ownerId uuid.UUID) ([]uuid.UUID, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectFavoriteUsers, id, ownerId)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIds []uuid.UUID
+	for rows.Next() {
+		var userId uuid.UUID
+		if err := rows.Scan(&userId); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		userIds = append(userIds, userId)
+	}
+	return userIds, nil
+}
+
+// savedSearchArgs spreads the filter of a saved search over the columns the
+// matcher compares new ads with, the same way filterArgs does for GetAds.
+func savedSearchArgs(search models.SavedSearch) []interface{} {
+	filter := search.Filter
+
+	currency := filter.Currency
+	if currency == "" {
+		currency = models.BaseCurrency
+	}
+
+	var categoryId, authorId interface{}
+	if filter.CategoryId != uuid.Nil {
+		categoryId = filter.CategoryId
+	}
+	if filter.AuthorId != uuid.Nil {
+		authorId = filter.AuthorId
+	}
+
+	tags := filter.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	var lat, lon interface{}
+	if filter.Location != nil {
+		lat, lon = filter.Location.Lat, filter.Location.Lon
+	}
+
+	return []interface{}{
+		search.UserId, search.Name, search.Query, filter.Query, filter.PriceMin, filter.PriceMax, currency, categoryId, authorId,
+		filter.AuthorLogin, tags, filter.TagsAll, lat, lon, filter.RadiusKm, savedAttributes(filter.Attributes),
+	}
+}
+
+// savedAttributes stores attribute filters with their value parsed as a
+// number where filterQuery would compare it as one.
+func savedAttributes(attrs []models.AttributeFilter) []map[string]interface{} {
+	saved := make([]map[string]interface{}, 0, len(attrs))
+	for _, attr := range attrs {
+		var number interface{}
+		if n, err := strconv.ParseFloat(attr.Value, 64); err == nil && !math.IsNaN(n) && !math.IsInf(n, 0) {
+			number = n
+		}
+		saved = append(saved, map[string]interface{}{"name": attr.Name, "op": attr.Op, "value": attr.Value, "number": number})
+	}
+	return saved
+}
+
+// InsertSavedSearch saves a search unless the user already has maxSearches
+// of them. The counter of the user is raised first, which locks the user
+// row, so concurrent inserts cannot both pass the limit.
+func (r *AdRepo) InsertSavedSearch(ctx context.Context, search models.SavedSearch, maxSearches int) (models.SavedSearch, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	args := append(savedSearchArgs(search), maxSearches)
+	err := r.db.QueryRow(ctx, insertSavedSearch, args...).Scan(&search.Id, &search.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(advertisement.ErrTooManySearches.Error())
+		return models.SavedSearch{}, advertisement.ErrTooManySearches
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.SavedSearch{}, advertisement.ErrSavingSearch
+	}
+
+	loggerVar.Info("Successful")
+	return search, nil
+}
+
+// SelectSavedSearches returns searches of a user without their filters,
+// Query is enough to restore one.
+func (r *AdRepo) SelectSavedSearches(ctx context.Context, userId uuid.UUID) ([]models.SavedSearch, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectSavedSearches, userId)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	searches := make([]models.SavedSearch, 0)
+	for rows.Next() {
+		search := models.SavedSearch{UserId: userId}
+		if err := rows.Scan(&search.Id, &search.Name, &search.Query, &search.CreatedAt); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		searches = append(searches, search)
+	}
+	return searches, nil
+}
+
+func (r *AdRepo) DeleteSavedSearch(ctx context.Context, userId, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, deleteSavedSearch, userId, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return advertisement.ErrSavingSearch
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(advertisement.ErrSearchNotFound.Error())
+		return advertisement.ErrSearchNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (r *AdRepo) SelectNotifications(ctx context.Context, userId uuid.UUID, limit, offset int) ([]models.Notification, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectNotifications, userId, limit, offset)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := make([]models.Notification, 0, limit)
+	for rows.Next() {
+		var n models.Notification
+		if err := rows.Scan(&n.Id, &n.SearchId, &n.SearchName, &n.AdId, &n.AdTitle, &n.CreatedAt); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+// MatchSavedSearches takes up to limit new ads off the queue and notifies
+// owners of the saved searches they match. Every search is compared with
+// the whole batch by one query, the search text is kept parsed in the table.
+// It returns how many ads were taken and the notifications made.
+func (r *AdRepo) MatchSavedSearches(ctx context.Context, limit int) (int, []models.Notification, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, matchSavedSearches, limit)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return 0, nil, err
+	}
+	defer rows.Close()
+
+	// the count of taken ads comes with every row, a batch without matches
+	// gives a single row of NULLs
+	var processed int
+	var notifications []models.Notification
+	for rows.Next() {
+		var (
+			n                          models.Notification
+			id, userId, searchId, adId uuid.NullUUID
+			searchName, adTitle        *string
+			createdAt                  *time.Time
+		)
+		if err := rows.Scan(&processed, &id, &userId, &searchId, &searchName, &adId, &adTitle, &createdAt); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return 0, nil, err
+		}
+		if !id.Valid {
+			continue
+		}
+		n.Id, n.UserId, n.SearchId, n.AdId = id.UUID, userId.UUID, searchId.UUID, adId.UUID
+		n.SearchName, n.AdTitle, n.CreatedAt = *searchName, *adTitle, *createdAt
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return 0, nil, err
+	}
+
+	return processed, notifications, nil
+}
+
+// InsertAdViews saves a batch of views at once. A view of a viewer already
+// counted for the ad in the same window is dropped, the others are added to
+// the daily counts. Views of ads purged in the meantime are dropped too.
+func (r *AdRepo) InsertAdViews(ctx context.Context, views []models.AdView, window time.Duration) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	adIds := make([]uuid.UUID, 0, len(views))
+	kinds := make([]string, 0, len(views))
+	viewers := make([]string, 0, len(views))
+	viewedAt := make([]time.Time, 0, len(views))
+	for _, view := range views {
+		adIds = append(adIds, view.AdId)
+		kinds = append(kinds, string(view.Kind))
+		viewers = append(viewers, view.Viewer)
+		viewedAt = append(viewedAt, view.ViewedAt)
+	}
+
+	if _, err := r.db.Exec(ctx, insertAdViews, adIds, kinds, viewers, viewedAt, int(window.Seconds())); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	return nil
+}
+
+// PurgeAdViews removes dedup rows of windows which started before before.
+func (r *AdRepo) PurgeAdViews(ctx context.Context, before time.Time) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, purgeAdViews, before)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+
+	loggerVar.Info("Successful", slog.Int64("purged", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
+
+// SelectAdStats returns the daily counts of an ad from from to to, days
+// without views are left out.
+func (r *AdRepo) SelectAdStats(ctx context.Context, id uuid.UUID, from, to time.Time) ([]models.AdDayStats, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectAdStats, id, from, to)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []models.AdDayStats
+	for rows.Next() {
+		var day models.AdDayStats
+		if err := rows.Scan(&day.Day, &day.Views, &day.Impressions); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		stats = append(stats, day)
+	}
+	return stats, nil
+}
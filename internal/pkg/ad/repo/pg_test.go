@@ -0,0 +1,62 @@
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterQuery(t *testing.T) {
+	filter := models.Filter{
+		Attributes: []models.AttributeFilter{
+			{Name: "fuel", Op: "eq", Value: "дизель"},
+			{Name: "rooms", Op: "eq", Value: "2"},
+			{Name: "year", Op: "min", Value: "2015"},
+		},
+	}
+
+	query, args := filterQuery(filter)
+	n := len(filterArgs(filter))
+
+	assert.True(t, strings.HasPrefix(query, adsFilter))
+	assert.Equal(t, []interface{}{"fuel", "дизель", "rooms", "2", 2.0, "year", 2015.0}, args[n:])
+	assert.Contains(t, query, fmt.Sprintf("jsonb_build_object($%d::text, $%d::text))", n+1, n+2))
+	assert.Contains(t, query, fmt.Sprintf("jsonb_build_object($%[1]d::text, $%[2]d::text) OR a.attributes @> jsonb_build_object($%[1]d::text, $%[3]d::numeric))", n+3, n+4, n+5))
+	assert.Contains(t, query, fmt.Sprintf("(a.attributes ->> $%d::text)::numeric >= $%d", n+6, n+7))
+	assert.NotContains(t, query, "дизель")
+}
+
+func TestBoundingBox(t *testing.T) {
+	latMin, latMax, lonMin, lonMax := boundingBox(models.GeoPoint{Lat: 55.75, Lon: 37.62}, 10)
+	assert.InDelta(t, 55.66, latMin, 0.001)
+	assert.InDelta(t, 55.84, latMax, 0.001)
+	assert.InDelta(t, 37.46, lonMin, 0.001)
+	assert.InDelta(t, 37.78, lonMax, 0.001)
+
+	// the box around a point next to the pole covers every longitude
+	latMin, latMax, lonMin, lonMax = boundingBox(models.GeoPoint{Lat: 89.95, Lon: 10}, 50)
+	assert.Equal(t, 90.0, latMax)
+	assert.Less(t, latMin, 89.95)
+	assert.Equal(t, []float64{-180, 180}, []float64{lonMin, lonMax})
+
+	// and so does the box crossing the antimeridian
+	_, _, lonMin, lonMax = boundingBox(models.GeoPoint{Lat: 64.73, Lon: 177.5}, 200)
+	assert.Equal(t, []float64{-180, 180}, []float64{lonMin, lonMax})
+}
+
+func TestSavedAttributes(t *testing.T) {
+	saved := savedAttributes([]models.AttributeFilter{
+		{Name: "fuel", Op: "eq", Value: "дизель"},
+		{Name: "rooms", Op: "eq", Value: "2"},
+		{Name: "year", Op: "min", Value: "2015"},
+	})
+
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "fuel", "op": "eq", "value": "дизель", "number": nil},
+		{"name": "rooms", "op": "eq", "value": "2", "number": 2.0},
+		{"name": "year", "op": "min", "value": "2015", "number": 2015.0},
+	}, saved)
+}
@@ -0,0 +1,27 @@
+a.base_price >= convert_price($1::bigint, $17, 'RUB')
+    AND ($2::bigint = 0 OR a.base_price <= convert_price($2::bigint, $17, 'RUB'))
+    AND ($3 = '' OR a.search_vector @@ (websearch_to_tsquery('russian', $3) || websearch_to_tsquery('simple', $3)))
+    AND ($4::uuid IS NULL OR a.user_id = $4)
+    AND ($5 = '' OR u.login = $5)
+    AND CASE WHEN cardinality($6::text[]) = 0
+        THEN a.status = 'published' AND a.expires_at > now()
+        ELSE a.status = ANY($6)
+    END
+    AND ($7::uuid IS NULL OR a.category_id IN (
+        WITH RECURSIVE subtree AS (
+            SELECT id FROM categories WHERE id = $7
+            UNION ALL
+            SELECT c.id FROM categories AS c JOIN subtree AS s ON c.parent_id = s.id
+        )
+        SELECT id FROM subtree
+    ))
+    AND (cardinality($8::text[]) = 0 OR (
+        SELECT count(*) FROM ad_tags AS at JOIN tags AS t ON at.tag_id = t.id
+        WHERE at.ad_id = a.id AND t.name = ANY($8)
+    ) >= CASE WHEN $9::boolean THEN cardinality($8) ELSE 1 END)
+    AND ($10::double precision IS NULL OR $12::double precision = 0 OR (
+        a.latitude BETWEEN $13 AND $14 AND a.longitude BETWEEN $15 AND $16
+        AND earth_distance_km(a.latitude, a.longitude, $10, $11) <= $12
+    ))
+    AND ($18::uuid IS NULL OR EXISTS (SELECT 1 FROM favorites AS f WHERE f.ad_id = a.id AND f.user_id = $18))
+    AND a.deleted_at IS NULL
\ No newline at end of file
@@ -0,0 +1,9 @@
+UPDATE ads
+SET status = 'archived', version = version + 1
+WHERE id IN (
+    SELECT id FROM ads
+    WHERE status IN ('published', 'reserved') AND expires_at <= $1 AND deleted_at IS NULL
+    ORDER BY expires_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
\ No newline at end of file
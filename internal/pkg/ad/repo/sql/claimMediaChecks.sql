@@ -0,0 +1,10 @@
+UPDATE ad_media_checks
+SET attempts = attempts + 1, next_attempt_at = $2
+WHERE ad_id IN (
+    SELECT ad_id FROM ad_media_checks
+    WHERE state = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING ad_id, target_status, attempts
\ No newline at end of file
@@ -0,0 +1,10 @@
+WITH done AS (
+    DELETE FROM ad_media_checks
+    WHERE ad_id = $1 AND state = 'pending'
+    RETURNING ad_id, target_status
+)
+UPDATE ads
+SET status = done.target_status, version = ads.version + 1
+FROM done
+WHERE ads.id = done.ad_id AND ads.status = 'pending_media'
+RETURNING ads.user_id
\ No newline at end of file
@@ -0,0 +1,4 @@
+SELECT count(*)
+FROM ads AS a
+JOIN users AS u ON a.user_id = u.id
+WHERE %s
\ No newline at end of file
@@ -0,0 +1,3 @@
+SELECT count(*)
+FROM images
+WHERE user_id = $1 AND id = ANY($2)
\ No newline at end of file
@@ -0,0 +1,16 @@
+WITH gallery AS (
+    -- locking the whole gallery makes a concurrent removal wait, so it sees
+    -- this one and the last image is never removed by two requests at once
+    SELECT id FROM ad_images WHERE ad_id = $1 ORDER BY id FOR UPDATE
+), deleted AS (
+    DELETE FROM ad_images
+    WHERE id = $2 AND ad_id = $1 AND (SELECT count(*) FROM gallery) > 1
+    RETURNING position, is_cover
+), shifted AS (
+    UPDATE ad_images AS i
+    SET position = i.position - (i.position > d.position)::int,
+        is_cover = i.is_cover OR (d.is_cover AND i.position - (i.position > d.position)::int = 0)
+    FROM deleted AS d
+    WHERE i.ad_id = $1 AND i.id <> $2
+)
+SELECT EXISTS (SELECT 1 FROM gallery WHERE id = $2), EXISTS (SELECT 1 FROM deleted)
\ No newline at end of file
@@ -0,0 +1,6 @@
+WITH removed AS (
+    DELETE FROM favorites WHERE user_id = $1 AND ad_id = $2
+    RETURNING ad_id
+)
+UPDATE ads SET favorites_count = favorites_count - 1
+WHERE id IN (SELECT ad_id FROM removed)
\ No newline at end of file
@@ -0,0 +1,7 @@
+WITH removed AS (
+    DELETE FROM saved_searches
+    WHERE id = $2 AND user_id = $1
+    RETURNING user_id
+)
+UPDATE users SET saved_searches_count = saved_searches_count - 1
+WHERE id IN (SELECT user_id FROM removed)
\ No newline at end of file
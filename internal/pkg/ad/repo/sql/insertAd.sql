@@ -1 +1,24 @@
-INSERT INTO ads (id, user_id, title, description, price, image_url, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
\ No newline at end of file
+WITH ad AS (
+    INSERT INTO ads (id, user_id, category_id, title, description, price, created_at, status, expires_at, attributes, city, latitude, longitude, currency, base_price)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $19, convert_price($6, $19, 'RUB'))
+    RETURNING id
+), images AS (
+    INSERT INTO ad_images (id, ad_id, url, image_id, position, is_cover)
+    SELECT i.id, ad.id, i.url, NULLIF(i.image_id, '00000000-0000-0000-0000-000000000000'), i.ord - 1, i.ord = 1
+    FROM ad, unnest($15::uuid[], $16::text[], $17::uuid[]) WITH ORDINALITY AS i(id, url, image_id, ord)
+), media_check AS (
+    INSERT INTO ad_media_checks (ad_id, target_status)
+    SELECT ad.id, $18 FROM ad WHERE $18::text <> ''
+), history AS (
+    INSERT INTO ad_price_history (ad_id, price, currency)
+    SELECT ad.id, $6, $19 FROM ad
+), match_queue AS (
+    INSERT INTO search_match_queue (ad_id)
+    SELECT ad.id FROM ad
+), tag_ids AS (
+    INSERT INTO tags (name) SELECT unnest($14::text[])
+    ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+    RETURNING id
+)
+INSERT INTO ad_tags (ad_id, tag_id)
+SELECT ad.id, tag_ids.id FROM ad, tag_ids
\ No newline at end of file
@@ -0,0 +1,10 @@
+WITH uncovered AS (
+    UPDATE ad_images SET is_cover = false
+    WHERE ad_id = $2 AND is_cover AND $4::boolean
+        AND (SELECT count(*) FROM ad_images WHERE ad_id = $2) < $5
+)
+INSERT INTO ad_images (id, ad_id, url, image_id, position, is_cover)
+SELECT $1, $2, $3, $6::uuid, count(*), $4 OR count(*) = 0
+FROM ad_images
+WHERE ad_id = $2
+HAVING count(*) < $5
\ No newline at end of file
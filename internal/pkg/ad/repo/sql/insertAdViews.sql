@@ -0,0 +1,16 @@
+WITH new_views AS (
+    INSERT INTO ad_views (ad_id, kind, viewer, window_start)
+    SELECT v.ad_id, v.kind, v.viewer, to_timestamp(floor(extract(epoch FROM v.viewed_at) / $5::int) * $5::int)
+    FROM unnest($1::uuid[], $2::text[], $3::text[], $4::timestamptz[]) AS v(ad_id, kind, viewer, viewed_at)
+    JOIN ads AS a ON v.ad_id = a.id
+    ON CONFLICT DO NOTHING
+    RETURNING ad_id, kind, window_start
+)
+INSERT INTO ad_daily_stats (ad_id, day, views, impressions)
+SELECT ad_id, (window_start AT TIME ZONE 'UTC')::date,
+    count(*) FILTER (WHERE kind = 'view'), count(*) FILTER (WHERE kind = 'impression')
+FROM new_views
+GROUP BY 1, 2
+ORDER BY 1, 2
+ON CONFLICT (ad_id, day) DO UPDATE
+SET views = ad_daily_stats.views + EXCLUDED.views, impressions = ad_daily_stats.impressions + EXCLUDED.impressions
\ No newline at end of file
@@ -0,0 +1,8 @@
+WITH added AS (
+    INSERT INTO favorites (user_id, ad_id)
+    VALUES ($1, $2)
+    ON CONFLICT DO NOTHING
+    RETURNING ad_id
+)
+UPDATE ads SET favorites_count = favorites_count + 1
+WHERE id IN (SELECT ad_id FROM added)
\ No newline at end of file
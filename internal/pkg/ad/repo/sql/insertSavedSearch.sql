@@ -0,0 +1,11 @@
+WITH counted AS (
+    UPDATE users SET saved_searches_count = saved_searches_count + 1
+    WHERE id = $1 AND saved_searches_count < $17
+    RETURNING id
+)
+INSERT INTO saved_searches (user_id, name, query, search_text, price_min, price_max, currency, base_price_range, category_id, author_id, author_login, tags, tags_all, latitude, longitude, radius_km, attributes)
+SELECT counted.id, $2, $3, $4, $5::bigint, $6::bigint, $7,
+    int8range(convert_price($5::bigint, $7, 'RUB'), CASE WHEN $6::bigint > 0 THEN convert_price($6::bigint, $7, 'RUB') END, '[]'),
+    $8::uuid, $9::uuid, $10, $11::text[], $12::boolean, $13::double precision, $14::double precision, $15::double precision, $16::jsonb
+FROM counted
+RETURNING id, created_at
\ No newline at end of file
@@ -0,0 +1,59 @@
+WITH batch AS (
+    DELETE FROM search_match_queue
+    WHERE ad_id IN (
+        SELECT q.ad_id FROM search_match_queue AS q
+        JOIN ads AS a ON q.ad_id = a.id
+        -- ads with unchecked images wait for the check, every other ad
+        -- leaves the queue, drafts are queued again once published
+        WHERE a.status <> 'pending_media' OR a.deleted_at IS NOT NULL
+        ORDER BY q.queued_at
+        LIMIT $1
+        FOR UPDATE OF q SKIP LOCKED
+    )
+    RETURNING ad_id
+), new_ads AS (
+    SELECT a.id, a.user_id, a.title, a.base_price, a.search_vector, a.attributes, a.latitude, a.longitude, u.login,
+        ARRAY(SELECT t.name FROM ad_tags AS at JOIN tags AS t ON at.tag_id = t.id WHERE at.ad_id = a.id) AS tags,
+        ARRAY(
+            WITH RECURSIVE path AS (
+                SELECT id, parent_id FROM categories WHERE id = a.category_id
+                UNION ALL
+                SELECT c.id, c.parent_id FROM categories AS c JOIN path AS p ON c.id = p.parent_id
+            )
+            SELECT id FROM path
+        ) AS categories
+    FROM batch
+    JOIN ads AS a ON batch.ad_id = a.id
+    JOIN users AS u ON a.user_id = u.id
+    WHERE a.status = 'published' AND a.deleted_at IS NULL
+), matched AS (
+    INSERT INTO notifications (user_id, search_id, ad_id)
+    SELECT s.user_id, s.id, a.id
+    FROM new_ads AS a
+    JOIN saved_searches AS s ON s.base_price_range @> a.base_price
+        AND (s.category_id = ANY(a.categories) OR s.category_id IS NULL)
+        AND (s.tags && a.tags OR s.tags = '{}')
+        AND s.user_id <> a.user_id
+        AND (s.author_id IS NULL OR s.author_id = a.user_id)
+        AND (s.author_login = '' OR s.author_login = a.login)
+        AND (NOT s.tags_all OR s.tags <@ a.tags)
+        AND (s.latitude IS NULL OR s.radius_km = 0
+            OR earth_distance_km(a.latitude, a.longitude, s.latitude, s.longitude) <= s.radius_km)
+        AND (s.search_tsquery IS NULL OR a.search_vector @@ s.search_tsquery)
+        AND NOT EXISTS (
+            SELECT 1 FROM jsonb_to_recordset(s.attributes) AS f(name TEXT, op TEXT, value TEXT, number NUMERIC)
+            WHERE NOT coalesce(CASE f.op
+                WHEN 'eq' THEN a.attributes -> f.name IN (to_jsonb(f.value), to_jsonb(f.number))
+                    OR (f.value IN ('true', 'false') AND a.attributes -> f.name = to_jsonb(f.value = 'true'))
+                WHEN 'min' THEN CASE WHEN jsonb_typeof(a.attributes -> f.name) = 'number' THEN (a.attributes ->> f.name)::numeric >= f.number END
+                WHEN 'max' THEN CASE WHEN jsonb_typeof(a.attributes -> f.name) = 'number' THEN (a.attributes ->> f.name)::numeric <= f.number END
+            END, false)
+        )
+    ON CONFLICT (user_id, ad_id) DO NOTHING
+    RETURNING id, user_id, search_id, ad_id, created_at
+)
+SELECT (SELECT count(*) FROM batch), m.id, m.user_id, m.search_id, s.name, m.ad_id, a.title, m.created_at
+FROM (SELECT 1) AS one
+LEFT JOIN matched AS m ON true
+LEFT JOIN saved_searches AS s ON m.search_id = s.id
+LEFT JOIN new_ads AS a ON m.ad_id = a.id
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM ad_views WHERE window_start < $1
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM ads WHERE deleted_at IS NOT NULL AND deleted_at < $1
\ No newline at end of file
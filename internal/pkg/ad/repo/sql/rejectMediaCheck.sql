@@ -0,0 +1,11 @@
+WITH rejected AS (
+    UPDATE ad_media_checks
+    SET state = 'rejected', reason = $2, checked_at = now()
+    WHERE ad_id = $1 AND state = 'pending'
+    RETURNING ad_id
+)
+UPDATE ads
+SET status = 'rejected', version = ads.version + 1
+FROM rejected
+WHERE ads.id = rejected.ad_id AND ads.status = 'pending_media'
+RETURNING ads.user_id
\ No newline at end of file
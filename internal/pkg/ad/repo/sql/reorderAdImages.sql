@@ -0,0 +1,4 @@
+UPDATE ad_images AS i
+SET position = o.ord - 1, is_cover = (i.id = $3)
+FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, ord)
+WHERE i.ad_id = $1 AND i.id = o.id
\ No newline at end of file
@@ -0,0 +1 @@
+UPDATE ads SET deleted_at = NULL, version = version + 1 WHERE id = $1 AND deleted_at IS NOT NULL
\ No newline at end of file
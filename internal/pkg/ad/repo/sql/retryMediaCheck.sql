@@ -0,0 +1,3 @@
+UPDATE ad_media_checks
+SET reason = $2, next_attempt_at = $3, checked_at = now()
+WHERE ad_id = $1 AND state = 'pending'
\ No newline at end of file
@@ -0,0 +1,11 @@
+SELECT a.id, a.user_id, a.category_id, a.title, a.description, a.price, a.currency, a.base_price, NULL::bigint,
+    CASE WHEN price_drop(a.price, a.currency, a.previous_price, a.previous_currency) IS NOT NULL THEN convert_price(a.previous_price, a.previous_currency, a.currency) END,
+    NULL::bigint, a.created_at, u.login, a.version, a.status, a.expires_at, a.attributes,
+    ARRAY(SELECT t.name FROM ad_tags AS at JOIN tags AS t ON at.tag_id = t.id WHERE at.ad_id = a.id ORDER BY t.name),
+    (SELECT coalesce(jsonb_agg(jsonb_build_object('id', i.id, 'url', i.url, 'image_id', i.image_id, 'position', i.position, 'is_cover', i.is_cover) ORDER BY i.position), '[]')
+        FROM ad_images AS i WHERE i.ad_id = a.id),
+    a.city, a.latitude, a.longitude, NULL::double precision,
+    a.favorites_count, false
+FROM ads AS a
+JOIN users AS u ON a.user_id = u.id
+WHERE a.id = $1 AND a.deleted_at IS NULL
\ No newline at end of file
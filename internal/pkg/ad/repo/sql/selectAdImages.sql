@@ -0,0 +1,4 @@
+SELECT id, url, image_id, position, is_cover
+FROM ad_images
+WHERE ad_id = $1
+ORDER BY position
\ No newline at end of file
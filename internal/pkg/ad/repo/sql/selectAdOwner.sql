@@ -0,0 +1 @@
+SELECT user_id, deleted_at IS NOT NULL FROM ads WHERE id = $1
\ No newline at end of file
@@ -0,0 +1,4 @@
+SELECT day, views, impressions
+FROM ad_daily_stats
+WHERE ad_id = $1 AND day BETWEEN $2 AND $3
+ORDER BY day
\ No newline at end of file
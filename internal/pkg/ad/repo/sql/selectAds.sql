@@ -1,6 +1,16 @@
-SELECT a.id, a.user_id, a.title, a.description, a.price, a.image_url, a.created_at, u.login
+SELECT a.id, a.user_id, a.category_id, a.title, a.description, a.price, a.currency, a.base_price, convert_price(a.price, a.currency, $17),
+    CASE WHEN price_drop(a.price, a.currency, a.previous_price, a.previous_currency) IS NOT NULL THEN convert_price(a.previous_price, a.previous_currency, a.currency) END,
+    CASE WHEN price_drop(a.price, a.currency, a.previous_price, a.previous_currency) IS NOT NULL THEN convert_price(a.previous_price, a.previous_currency, $17) END,
+    a.created_at, u.login, a.version, a.status, a.expires_at, a.attributes,
+    ARRAY(SELECT t.name FROM ad_tags AS at JOIN tags AS t ON at.tag_id = t.id WHERE at.ad_id = a.id ORDER BY t.name),
+    (SELECT coalesce(jsonb_agg(jsonb_build_object('id', i.id, 'url', i.url, 'image_id', i.image_id, 'position', i.position, 'is_cover', i.is_cover) ORDER BY i.position), '[]')
+        FROM ad_images AS i WHERE i.ad_id = a.id),
+    a.city, a.latitude, a.longitude, earth_distance_km(a.latitude, a.longitude, $10, $11),
+    a.favorites_count,
+    EXISTS (SELECT 1 FROM favorites AS f WHERE f.ad_id = a.id AND f.user_id = $%[9]d::uuid)
 FROM ads AS a
 JOIN users AS u ON a.user_id = u.id
-WHERE a.price >= $1 AND a.price <= $2
-ORDER BY %s %s
-LIMIT $3 OFFSET $4
\ No newline at end of file
+WHERE %[4]s
+    AND ($%[7]d::uuid IS NULL OR (%[1]s, a.id) %[3]s ($%[8]d, $%[7]d))
+ORDER BY %[1]s %[2]s NULLS LAST, a.id %[2]s
+LIMIT $%[5]d OFFSET $%[6]d
\ No newline at end of file
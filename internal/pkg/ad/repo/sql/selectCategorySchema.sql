@@ -0,0 +1,8 @@
+WITH RECURSIVE ancestors AS (
+    SELECT id, parent_id, attributes, 0 AS depth FROM categories WHERE id = $1
+    UNION ALL
+    SELECT c.id, c.parent_id, c.attributes, a.depth + 1
+    FROM categories AS c
+    JOIN ancestors AS a ON c.id = a.parent_id
+)
+SELECT attributes FROM ancestors ORDER BY depth DESC
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT EXISTS (SELECT 1 FROM exchange_rates WHERE currency = $1)
\ No newline at end of file
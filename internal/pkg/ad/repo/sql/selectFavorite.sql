@@ -0,0 +1 @@
+SELECT EXISTS (SELECT 1 FROM favorites WHERE user_id = $1 AND ad_id = $2)
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT user_id FROM favorites WHERE ad_id = $1 AND user_id <> $2
\ No newline at end of file
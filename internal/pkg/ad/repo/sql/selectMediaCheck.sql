@@ -0,0 +1,3 @@
+SELECT target_status, state = 'rejected', reason, attempts, checked_at
+FROM ad_media_checks
+WHERE ad_id = $1
\ No newline at end of file
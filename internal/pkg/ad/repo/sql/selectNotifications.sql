@@ -0,0 +1,7 @@
+SELECT n.id, n.search_id, s.name, n.ad_id, a.title, n.created_at
+FROM notifications AS n
+JOIN saved_searches AS s ON n.search_id = s.id
+JOIN ads AS a ON n.ad_id = a.id
+WHERE n.user_id = $1 AND a.deleted_at IS NULL
+ORDER BY n.created_at DESC, n.id DESC
+LIMIT $2 OFFSET $3
\ No newline at end of file
@@ -0,0 +1,8 @@
+SELECT t.name, count(*) AS uses
+FROM ad_tags AS at
+JOIN tags AS t ON at.tag_id = t.id
+JOIN ads AS a ON at.ad_id = a.id
+WHERE a.status = 'published' AND a.expires_at > now() AND a.deleted_at IS NULL
+GROUP BY t.name
+ORDER BY uses DESC, t.name
+LIMIT $1
\ No newline at end of file
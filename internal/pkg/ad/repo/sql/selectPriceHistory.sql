@@ -0,0 +1,5 @@
+SELECT price, currency, changed_at
+FROM ad_price_history
+WHERE ad_id = $1
+ORDER BY changed_at DESC
+LIMIT $2
\ No newline at end of file
@@ -0,0 +1,4 @@
+SELECT id, name, query, created_at
+FROM saved_searches
+WHERE user_id = $1
+ORDER BY created_at DESC, id
\ No newline at end of file
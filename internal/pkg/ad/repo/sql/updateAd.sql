@@ -0,0 +1,34 @@
+WITH updated AS (
+    UPDATE ads
+    SET title = $3, description = $4, price = $5, category_id = $7, attributes = $8, city = $9, latitude = $10, longitude = $11, currency = $13,
+        base_price = convert_price($5, $13, 'RUB'),
+        previous_price = CASE WHEN old.changed THEN old.price ELSE ads.previous_price END,
+        previous_currency = CASE WHEN old.changed THEN old.currency ELSE ads.previous_currency END,
+        version = ads.version + 1
+    FROM (
+        SELECT price, currency, (price, currency) IS DISTINCT FROM ($5::int, $13::text) AS changed
+        FROM ads WHERE id = $1
+    ) AS old
+    WHERE ads.id = $1 AND ads.version = $2 AND ads.deleted_at IS NULL
+    RETURNING ads.id, ads.version, old.changed, ads.base_price,
+        CASE WHEN price_drop(ads.price, ads.currency, ads.previous_price, ads.previous_currency) IS NOT NULL
+            THEN convert_price(ads.previous_price, ads.previous_currency, ads.currency) END AS previous_price
+), history AS (
+    INSERT INTO ad_price_history (ad_id, price, currency)
+    SELECT id, $5, $13 FROM updated WHERE changed
+), cover AS (
+    UPDATE ad_images SET url = $6, image_id = NULL
+    WHERE ad_id IN (SELECT id FROM updated) AND is_cover AND url <> $6
+), tag_ids AS (
+    INSERT INTO tags (name) SELECT unnest($12::text[]) FROM updated
+    ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+    RETURNING id
+), removed AS (
+    DELETE FROM ad_tags
+    WHERE ad_id IN (SELECT id FROM updated) AND tag_id NOT IN (SELECT id FROM tag_ids)
+), added AS (
+    INSERT INTO ad_tags (ad_id, tag_id)
+    SELECT updated.id, tag_ids.id FROM updated, tag_ids
+    ON CONFLICT DO NOTHING
+)
+SELECT version, base_price, previous_price FROM updated
\ No newline at end of file
@@ -0,0 +1,11 @@
+WITH updated AS (
+    UPDATE ads
+    SET status = $3, expires_at = $4, version = version + 1
+    WHERE id = $1 AND status = $2 AND deleted_at IS NULL
+    RETURNING id, version
+), match_queue AS (
+    INSERT INTO search_match_queue (ad_id)
+    SELECT id FROM updated WHERE $2 = 'draft' AND $3 = 'published'
+    ON CONFLICT DO NOTHING
+)
+SELECT version FROM updated
\ No newline at end of file
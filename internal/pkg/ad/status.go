@@ -0,0 +1,63 @@
+package ad
+
+import (
+	"fmt"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+)
+
+// transitions lists the statuses an ad may move to from a given status.
+// Only published ads are visible in GetAds. pending_media and rejected are
+// set and left by the image check only.
+var transitions = map[models.AdStatus][]models.AdStatus{
+	models.AdStatusDraft:        {models.AdStatusPublished, models.AdStatusArchived},
+	models.AdStatusPendingMedia: nil,
+	models.AdStatusPublished:    {models.AdStatusReserved, models.AdStatusSold, models.AdStatusArchived},
+	models.AdStatusReserved:     {models.AdStatusPublished, models.AdStatusSold, models.AdStatusArchived},
+	models.AdStatusSold:         {models.AdStatusArchived},
+	models.AdStatusArchived:     {models.AdStatusDraft, models.AdStatusPublished},
+	models.AdStatusRejected:     nil,
+}
+
+// TransitionError is returned when an ad can not be moved to the requested
+// status from its current one.
+type TransitionError struct {
+	From models.AdStatus
+	To   models.AdStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot change ad status from %s to %s", e.From, e.To)
+}
+
+func ValidStatus(status models.AdStatus) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+func CanTransition(from, to models.AdStatus) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// AllStatuses returns every ad status in lifecycle order.
+func AllStatuses() []models.AdStatus {
+	return []models.AdStatus{
+		models.AdStatusDraft,
+		models.AdStatusPendingMedia,
+		models.AdStatusPublished,
+		models.AdStatusReserved,
+		models.AdStatusSold,
+		models.AdStatusArchived,
+		models.AdStatusRejected,
+	}
+}
+
+// Hidden reports whether ads in status are shown to their owners only.
+func Hidden(status models.AdStatus) bool {
+	return status == models.AdStatusDraft || status == models.AdStatusPendingMedia || status == models.AdStatusRejected
+}
@@ -0,0 +1,38 @@
+package ad
+
+import (
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		name string
+		from models.AdStatus
+		to   models.AdStatus
+		want bool
+	}{
+		{"publish draft", models.AdStatusDraft, models.AdStatusPublished, true},
+		{"reserve published", models.AdStatusPublished, models.AdStatusReserved, true},
+		{"sell reserved", models.AdStatusReserved, models.AdStatusSold, true},
+		{"archive sold", models.AdStatusSold, models.AdStatusArchived, true},
+		{"sold to draft", models.AdStatusSold, models.AdStatusDraft, false},
+		{"sold to published", models.AdStatusSold, models.AdStatusPublished, false},
+		{"draft to sold", models.AdStatusDraft, models.AdStatusSold, false},
+		{"same status", models.AdStatusPublished, models.AdStatusPublished, false},
+		{"unknown status", "deleted", models.AdStatusPublished, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CanTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func TestTransitionError(t *testing.T) {
+	err := &TransitionError{From: models.AdStatusSold, To: models.AdStatusDraft}
+	assert.Equal(t, "cannot change ad status from sold to draft", err.Error())
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+// AddFavorite saves an ad visible to userId to the user's favorites. Saving
+// it again changes nothing.
+func (uc *AdUsecase) AddFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := uc.visibleAd(ctx, userId, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.InsertFavorite(ctx, userId, id); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// RemoveFavorite removes an ad from favorites of userId. An ad which is not
+// there is not an error, and neither is an ad hidden since it was saved.
+func (uc *AdUsecase) RemoveFavorite(ctx context.Context, userId, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.repo.DeleteFavorite(ctx, userId, id); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// notifyPriceChanged tells users who saved an ad about its new price. The
+// price is already saved, so a failure only costs the events.
+func (uc *AdUsecase) notifyPriceChanged(ctx context.Context, advertisement models.Ad) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	userIds, err := uc.repo.SelectFavoriteUsers(ctx, advertisement.Id, advertisement.UserId)
+	if err != nil {
+		loggerVar.Error("error fetching favorite users: " + err.Error())
+		return
+	}
+
+	data := models.PriceChangedEvent{
+		AdId:     advertisement.Id,
+		Title:    advertisement.Title,
+		Price:    models.Money(advertisement.Price),
+		Currency: advertisement.Currency,
+	}
+	if advertisement.PreviousPrice != nil {
+		previous := models.Money(*advertisement.PreviousPrice)
+		data.PriceDropped, data.PreviousPrice = true, &previous
+	}
+	for _, userId := range userIds {
+		uc.events.Publish(ctx, userId, models.Event{Type: models.EventPriceChanged, Data: data})
+	}
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/fetcher"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/satori/uuid"
+)
+
+const (
+	// mediaLease is how long a worker owns a claimed check. It covers
+	// downloading a full gallery one image after another.
+	mediaLease = 5 * time.Minute
+	// maxMediaAttempts limits retries of images which could not be
+	// downloaded, the ad is rejected after that.
+	maxMediaAttempts = 5
+	mediaRetryDelay  = 30 * time.Second
+)
+
+// validateImage checks that link points to a supported image which is not
+// too large. The image is downloaded once, see fetcher.Fetcher.
+func (uc *AdUsecase) validateImage(ctx context.Context, link string) error {
+	if !validation.ValidImageLink(link) {
+		return fetcher.ErrInvalidURL
+	}
+	_, err := uc.images.Fetch(ctx, link)
+	return err
+}
+
+func hasRemoteImages(images models.AdImageList) bool {
+	for _, image := range images {
+		if image.ImageId == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (uc *AdUsecase) notifyMedia() {
+	select {
+	case uc.mediaQueued <- struct{}{}:
+	default:
+		// a wake up is already pending
+	}
+}
+
+// MediaQueued receives a value when a new ad waits for its images to be
+// checked, so the workers do not have to wait for their next round.
+func (uc *AdUsecase) MediaQueued() <-chan struct{} {
+	return uc.mediaQueued
+}
+
+// VerifyPendingMedia checks remote images of pending ads with up to workers
+// checks running at once and returns how many checks were processed. An
+// ad with good images gets the status it was created with, a bad image
+// rejects it. Images which could not be downloaded are tried again later.
+func (uc *AdUsecase) VerifyPendingMedia(ctx context.Context, workers int) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	total := 0
+	for ctx.Err() == nil {
+		checks, err := uc.repo.ClaimMediaChecks(ctx, workers, time.Now().Add(mediaLease))
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return total, err
+		}
+
+		var wg sync.WaitGroup
+		for _, check := range checks {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := uc.verifyMedia(ctx, check); err != nil {
+					loggerVar.Error("error checking images: "+err.Error(), slog.String("ad", check.AdId.String()))
+				}
+			}()
+		}
+		wg.Wait()
+
+		total += len(checks)
+		if len(checks) < workers {
+			break
+		}
+	}
+
+	if total > 0 {
+		loggerVar.Info("Successful", slog.Int("checked", total))
+	}
+	return total, nil
+}
+
+func (uc *AdUsecase) verifyMedia(ctx context.Context, check models.MediaCheck) error {
+	images, err := uc.repo.SelectAdImages(ctx, check.AdId)
+	if err != nil {
+		return err
+	}
+
+	for i, image := range images {
+		if image.ImageId != nil {
+			continue
+		}
+		err := uc.validateImage(ctx, image.URL)
+		if err == nil {
+			continue
+		}
+
+		reason := fmt.Sprintf("image %d: %s", i+1, err.Error())
+		if errors.Is(err, fetcher.ErrUnavailable) && check.Attempts < maxMediaAttempts {
+			nextAttempt := time.Now().Add(time.Duration(check.Attempts) * mediaRetryDelay)
+			return uc.repo.RetryMediaCheck(ctx, check.AdId, reason, nextAttempt)
+		}
+		ownerId, err := uc.repo.RejectMediaCheck(ctx, check.AdId, reason)
+		if err != nil {
+			return err
+		}
+		uc.notifyModerated(ctx, ownerId, models.AdModeratedEvent{AdId: check.AdId, Status: models.AdStatusRejected, Reason: reason})
+		return nil
+	}
+
+	ownerId, err := uc.repo.CompleteMediaCheck(ctx, check.AdId)
+	if err != nil {
+		return err
+	}
+	uc.notifyModerated(ctx, ownerId, models.AdModeratedEvent{AdId: check.AdId, Status: check.TargetStatus})
+	return nil
+}
+
+// notifyModerated tells the owner of an ad how the check of its images
+// ended. Checks finished elsewhere have no owner and are not reported twice.
+func (uc *AdUsecase) notifyModerated(ctx context.Context, ownerId uuid.UUID, data models.AdModeratedEvent) {
+	if ownerId == uuid.Nil {
+		return
+	}
+	uc.events.Publish(ctx, ownerId, models.Event{Type: models.EventAdModerated, Data: data})
+}
+
+// GetAdVerification lets the owner poll the check of the images of an ad
+// created with remote images.
+func (uc *AdUsecase) GetAdVerification(ctx context.Context, userId, id uuid.UUID) (models.AdVerification, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	advertisement, err := uc.repo.SelectAdById(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.AdVerification{}, err
+	}
+	if advertisement.UserId != userId {
+		loggerVar.Error(ad.ErrForbidden.Error())
+		return models.AdVerification{}, ad.ErrForbidden
+	}
+
+	result := models.AdVerification{Status: advertisement.Status}
+	check, found, err := uc.repo.SelectMediaCheck(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.AdVerification{}, err
+	}
+	if found {
+		result.Reason = check.Reason
+		result.Attempts = check.Attempts
+		result.CheckedAt = check.CheckedAt
+	}
+	return result, nil
+}
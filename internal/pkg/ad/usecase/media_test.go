@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad/mocks"
+	eventsMocks "github.com/K1tten2005/go_vk_intern/internal/pkg/events/mocks"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/fetcher"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAdPendingMedia(t *testing.T) {
+	categoryId := uuid.NewV4()
+	uploadedId := uuid.NewV4()
+
+	tests := []struct {
+		name          string
+		images        models.AdImageList
+		status        models.AdStatus
+		wantStatus    models.AdStatus
+		wantPending   models.AdStatus
+		wantTriggered bool
+	}{
+		{
+			name:          "Remote image",
+			images:        models.AdImageList{{URL: "https://example.com/a.jpg"}},
+			wantStatus:    models.AdStatusPendingMedia,
+			wantPending:   models.AdStatusPublished,
+			wantTriggered: true,
+		},
+		{
+			name:          "Remote image of a draft",
+			images:        models.AdImageList{{URL: "https://example.com/a.jpg"}},
+			status:        models.AdStatusDraft,
+			wantStatus:    models.AdStatusPendingMedia,
+			wantPending:   models.AdStatusDraft,
+			wantTriggered: true,
+		},
+		{
+			name:       "Uploaded images only",
+			images:     models.AdImageList{{URL: "/api/images/" + uploadedId.String(), ImageId: &uploadedId}},
+			wantStatus: models.AdStatusPublished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectCategorySchema(gomock.Any(), categoryId).Return(nil, nil)
+			mockRepo.EXPECT().CountUserImages(gomock.Any(), gomock.Any(), gomock.Any()).Return(1, nil).AnyTimes()
+			mockRepo.EXPECT().InsertAd(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, data models.Ad) error {
+				assert.Equal(t, tt.wantStatus, data.Status)
+				assert.Equal(t, tt.wantPending, data.PendingStatus)
+				return nil
+			})
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			created, err := uc.CreateAd(context.Background(), models.Ad{CategoryId: categoryId, Images: tt.images, Status: tt.status})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, created.Status)
+			select {
+			case <-uc.MediaQueued():
+				assert.True(t, tt.wantTriggered, "unexpected wake up")
+			default:
+				assert.False(t, tt.wantTriggered, "workers were not woken up")
+			}
+		})
+	}
+}
+
+func TestVerifyPendingMedia(t *testing.T) {
+	id := uuid.NewV4()
+	ownerId := uuid.NewV4()
+	uploadedId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		images     models.AdImageList
+		attempts   int
+		fetchErr   error
+		repoMocker func(*mocks.MockAdRepo)
+		wantEvent  *models.AdModeratedEvent
+	}{
+		{
+			name:   "Uploaded images",
+			images: models.AdImageList{{ImageId: &uploadedId}},
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().CompleteMediaCheck(gomock.Any(), id).Return(ownerId, nil)
+			},
+			wantEvent: &models.AdModeratedEvent{AdId: id, Status: models.AdStatusPublished},
+		},
+		{
+			name:   "Finished by another worker",
+			images: models.AdImageList{{ImageId: &uploadedId}},
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().CompleteMediaCheck(gomock.Any(), id).Return(uuid.Nil, nil)
+			},
+		},
+		{
+			name:   "Remote image",
+			images: models.AdImageList{{URL: "https://example.com/a.jpg"}},
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().CompleteMediaCheck(gomock.Any(), id).Return(ownerId, nil)
+			},
+			wantEvent: &models.AdModeratedEvent{AdId: id, Status: models.AdStatusPublished},
+		},
+		{
+			name:     "Internal address",
+			images:   models.AdImageList{{ImageId: &uploadedId}, {URL: "https://example.com/a.jpg"}},
+			fetchErr: fetcher.ErrForbiddenAddress,
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().RejectMediaCheck(gomock.Any(), id, "image 2: image url points to a forbidden address").Return(ownerId, nil)
+			},
+			wantEvent: &models.AdModeratedEvent{AdId: id, Status: models.AdStatusRejected, Reason: "image 2: image url points to a forbidden address"},
+		},
+		{
+			name:     "Unavailable is retried",
+			images:   models.AdImageList{{URL: "https://example.com/a.jpg"}},
+			attempts: 1,
+			fetchErr: fetcher.ErrUnavailable,
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().RetryMediaCheck(gomock.Any(), id, "image 1: image is unavailable", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "Unavailable after the last attempt",
+			images:   models.AdImageList{{URL: "https://example.com/a.jpg"}},
+			attempts: maxMediaAttempts,
+			fetchErr: fetcher.ErrUnavailable,
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().RejectMediaCheck(gomock.Any(), id, "image 1: image is unavailable").Return(ownerId, nil)
+			},
+			wantEvent: &models.AdModeratedEvent{AdId: id, Status: models.AdStatusRejected, Reason: "image 1: image is unavailable"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().ClaimMediaChecks(gomock.Any(), 2, gomock.Any()).
+				Return([]models.MediaCheck{{AdId: id, TargetStatus: models.AdStatusPublished, Attempts: tt.attempts}}, nil)
+			mockRepo.EXPECT().SelectAdImages(gomock.Any(), id).Return(tt.images, nil)
+			tt.repoMocker(mockRepo)
+			mockEvents := eventsMocks.NewMockPublisher(ctrl)
+			if tt.wantEvent != nil {
+				mockEvents.EXPECT().Publish(gomock.Any(), ownerId, models.Event{Type: models.EventAdModerated, Data: *tt.wantEvent})
+			}
+
+			mockFetcher := mocks.NewMockImageFetcher(ctrl)
+			for _, image := range tt.images {
+				if image.ImageId == nil {
+					mockFetcher.EXPECT().Fetch(gomock.Any(), image.URL).Return(fetcher.Info{}, tt.fetchErr)
+				}
+			}
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, mockEvents, mockFetcher)
+			checked, err := uc.VerifyPendingMedia(context.Background(), 2)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, checked)
+		})
+	}
+}
+
+func TestGetAdVerification(t *testing.T) {
+	id := uuid.NewV4()
+	ownerId := uuid.NewV4()
+	checkedAt := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAdRepo(ctrl)
+	mockRepo.EXPECT().SelectAdById(gomock.Any(), id).Return(models.Ad{Id: id, UserId: ownerId, Status: models.AdStatusRejected}, nil).Times(2)
+	mockRepo.EXPECT().SelectMediaCheck(gomock.Any(), id).
+		Return(models.MediaCheck{AdId: id, Rejected: true, Reason: "image 1: unsupported image type", Attempts: 1, CheckedAt: &checkedAt}, true, nil)
+
+	uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+
+	verification, err := uc.GetAdVerification(context.Background(), ownerId, id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.AdVerification{Status: models.AdStatusRejected, Reason: "image 1: unsupported image type", Attempts: 1, CheckedAt: &checkedAt}, verification)
+
+	_, err = uc.GetAdById(context.Background(), uuid.NewV4(), id)
+	assert.Error(t, err, "rejected ads are hidden from other users")
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/satori/uuid"
+)
+
+const (
+	// maxSavedSearches bounds the number of searches of one user, every
+	// new ad is compared with all of them.
+	maxSavedSearches = 20
+	// maxSavedQueryLength bounds the query string a search is saved with.
+	maxSavedQueryLength = 2000
+	// matchBatchSize is how many new ads are matched by one query.
+	matchBatchSize = 100
+)
+
+// SaveSearch saves the filter of search.Query under search.Name.
+func (uc *AdUsecase) SaveSearch(ctx context.Context, search models.SavedSearch) (models.SavedSearch, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if !validation.ValidSearchName(search.Name) || len(search.Query) > maxSavedQueryLength {
+		loggerVar.Error(ad.ErrInvalidSearch.Error())
+		return models.SavedSearch{}, ad.ErrInvalidSearch
+	}
+	if err := uc.checkCurrency(ctx, search.Filter.Currency); err != nil {
+		return models.SavedSearch{}, err
+	}
+
+	saved, err := uc.repo.InsertSavedSearch(ctx, search, maxSavedSearches)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.SavedSearch{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return saved, nil
+}
+
+func (uc *AdUsecase) GetSavedSearches(ctx context.Context, userId uuid.UUID) ([]models.SavedSearch, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	searches, err := uc.repo.SelectSavedSearches(ctx, userId)
+	if err != nil {
+		loggerVar.Error("error fetching saved searches: " + err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return searches, nil
+}
+
+// DeleteSavedSearch removes a search of userId together with the
+// notifications it made.
+func (uc *AdUsecase) DeleteSavedSearch(ctx context.Context, userId, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.repo.DeleteSavedSearch(ctx, userId, id); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// GetNotifications returns a page of the inbox of userId, newest first.
+func (uc *AdUsecase) GetNotifications(ctx context.Context, userId uuid.UUID, page, limit int) ([]models.Notification, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	notifications, err := uc.repo.SelectNotifications(ctx, userId, limit, (page-1)*limit)
+	if err != nil {
+		loggerVar.Error("error fetching notifications: " + err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return notifications, nil
+}
+
+// MatchSavedSearches compares new ads with saved searches, fills the inboxes
+// of their owners and pushes the matches to their event streams. It works in batches until the queue is empty or
+// ctx is cancelled and returns the number of notifications made.
+func (uc *AdUsecase) MatchSavedSearches(ctx context.Context) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var total int64
+	for ctx.Err() == nil {
+		processed, notifications, err := uc.repo.MatchSavedSearches(ctx, matchBatchSize)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return total, err
+		}
+		total += int64(len(notifications))
+		for _, n := range notifications {
+			uc.events.Publish(ctx, n.UserId, models.Event{
+				Type: models.EventSearchMatch,
+				Data: models.NotificationResp{
+					Id:         n.Id,
+					SearchId:   n.SearchId,
+					SearchName: n.SearchName,
+					AdId:       n.AdId,
+					AdTitle:    n.AdTitle,
+					CreatedAt:  n.CreatedAt,
+				},
+			})
+		}
+		if processed < matchBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		loggerVar.Info("Successful", slog.Int64("notifications", total))
+	}
+	return total, nil
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad/mocks"
+	eventsMocks "github.com/K1tten2005/go_vk_intern/internal/pkg/events/mocks"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSaveSearch(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		search      models.SavedSearch
+		repoMocker  func(*mocks.MockAdRepo)
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			search: models.SavedSearch{UserId: userId, Name: "Велосипеды", Query: "q=велосипед"},
+			repoMocker: func(repo *mocks.MockAdRepo) {
+				repo.EXPECT().InsertSavedSearch(gomock.Any(), gomock.Any(), maxSavedSearches).
+					DoAndReturn(func(_ context.Context, s models.SavedSearch, _ int) (models.SavedSearch, error) {
+						s.Id = uuid.NewV4()
+						return s, nil
+					})
+			},
+		},
+		{
+			name:        "Empty name",
+			search:      models.SavedSearch{UserId: userId, Query: "q=велосипед"},
+			repoMocker:  func(*mocks.MockAdRepo) {},
+			expectedErr: ad.ErrInvalidSearch,
+		},
+		{
+			name: "Unknown currency",
+			search: models.SavedSearch{UserId: userId, Name: "Велосипеды", Query: "currency=XYZ&price_max=100",
+				Filter: models.Filter{Currency: "XYZ", PriceMax: 10000}},
+			repoMocker: func(repo *mocks.MockAdRepo) {
+				repo.EXPECT().CurrencyExists(gomock.Any(), "XYZ").Return(false, nil)
+			},
+			expectedErr: ad.ErrUnknownCurrency,
+		},
+		{
+			name:   "Too many searches",
+			search: models.SavedSearch{UserId: userId, Name: "Велосипеды", Query: "q=велосипед"},
+			repoMocker: func(repo *mocks.MockAdRepo) {
+				repo.EXPECT().InsertSavedSearch(gomock.Any(), gomock.Any(), maxSavedSearches).Return(models.SavedSearch{}, ad.ErrTooManySearches)
+			},
+			expectedErr: ad.ErrTooManySearches,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			tt.repoMocker(mockRepo)
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			result, err := uc.SaveSearch(context.Background(), tt.search)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.NotEqual(t, uuid.Nil, result.Id)
+				assert.Equal(t, tt.search.Query, result.Query)
+			}
+		})
+	}
+}
+
+func TestMatchSavedSearches(t *testing.T) {
+	t.Run("Until the queue is drained", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userId := uuid.NewV4()
+		notification := models.Notification{Id: uuid.NewV4(), UserId: userId, SearchId: uuid.NewV4(), SearchName: "Велосипеды", AdId: uuid.NewV4(), AdTitle: "Велосипед"}
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		gomock.InOrder(
+			mockRepo.EXPECT().MatchSavedSearches(gomock.Any(), matchBatchSize).Return(matchBatchSize, []models.Notification{notification, notification}, nil),
+			mockRepo.EXPECT().MatchSavedSearches(gomock.Any(), matchBatchSize).Return(3, []models.Notification{notification}, nil),
+		)
+		mockEvents := eventsMocks.NewMockPublisher(ctrl)
+		mockEvents.EXPECT().Publish(gomock.Any(), userId, models.Event{
+			Type: models.EventSearchMatch,
+			Data: models.NotificationResp{
+				Id:         notification.Id,
+				SearchId:   notification.SearchId,
+				SearchName: "Велосипеды",
+				AdId:       notification.AdId,
+				AdTitle:    "Велосипед",
+			},
+		}).Times(3)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, mockEvents, mocks.NewMockImageFetcher(ctrl))
+		total, err := uc.MatchSavedSearches(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("Repo failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dbErr := errors.New("db is down")
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().MatchSavedSearches(gomock.Any(), matchBatchSize).Return(0, nil, dbErr)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		_, err := uc.MatchSavedSearches(context.Background())
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+const (
+	// viewWindow is how long a viewer is counted once per ad. It divides a
+	// day, so a window never spans two daily buckets.
+	viewWindow = 30 * time.Minute
+	// viewQueueSize bounds the views waiting to be saved. Views coming in
+	// faster than they are saved are dropped rather than slowing down the
+	// requests recording them.
+	viewQueueSize = 10000
+	viewBatchSize = 500
+	// maxStatsDays limits the period of GetAdStats.
+	maxStatsDays = 366
+)
+
+// RecordViews queues views of ads by viewer without waiting for them to be
+// saved. Owners looking at their own ads are not counted.
+func (uc *AdUsecase) RecordViews(ctx context.Context, kind models.ViewKind, viewer models.Viewer, ads []models.Ad) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	now := time.Now()
+	dropped := 0
+	for _, advertisement := range ads {
+		if viewer.UserId != uuid.Nil && advertisement.UserId == viewer.UserId {
+			continue
+		}
+		select {
+		case uc.views <- models.AdView{AdId: advertisement.Id, Kind: kind, Viewer: viewer.Key, ViewedAt: now}:
+		default:
+			dropped++
+		}
+	}
+
+	if dropped > 0 {
+		loggerVar.Error("view queue is full", slog.Int("dropped", dropped))
+	}
+	if len(uc.views) >= viewBatchSize {
+		select {
+		case uc.viewsQueued <- struct{}{}:
+		default:
+			// a wake up is already pending
+		}
+	}
+}
+
+// ViewsQueued receives a value when a full batch of views waits to be
+// saved, so they are flushed before the queue fills up.
+func (uc *AdUsecase) ViewsQueued() <-chan struct{} {
+	return uc.viewsQueued
+}
+
+// FlushAdViews saves queued views in batches until the queue is empty and
+// returns how many were saved. A batch which fails to save is dropped and
+// the error is returned right away, the views still queued wait for the
+// next flush.
+func (uc *AdUsecase) FlushAdViews(ctx context.Context) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	total := 0
+	batch := make([]models.AdView, 0, viewBatchSize)
+	for {
+		batch = batch[:0]
+	collect:
+		for len(batch) < viewBatchSize {
+			select {
+			case view := <-uc.views:
+				batch = append(batch, view)
+			default:
+				break collect
+			}
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		if err := uc.repo.InsertAdViews(ctx, batch, viewWindow); err != nil {
+			loggerVar.Error("error saving views: "+err.Error(), slog.Int("dropped", len(batch)))
+			return total, err
+		}
+		total += len(batch)
+	}
+
+	if total > 0 {
+		loggerVar.Info("Successful", slog.Int("views", total))
+	}
+	return total, nil
+}
+
+// PurgeAdViews forgets who viewed ads in windows which are over, the daily
+// counts are kept.
+func (uc *AdUsecase) PurgeAdViews(ctx context.Context) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	purged, err := uc.repo.PurgeAdViews(ctx, time.Now().Add(-viewWindow))
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+	return purged, nil
+}
+
+// GetAdStats returns the daily views and impressions of an ad of userId
+// for the UTC days from from to to, both included.
+func (uc *AdUsecase) GetAdStats(ctx context.Context, userId, id uuid.UUID, from, to time.Time) (models.AdStats, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	from, to = truncateDay(from), truncateDay(to)
+	if to.Before(from) || to.Sub(from) >= maxStatsDays*24*time.Hour {
+		loggerVar.Error(ad.ErrInvalidPeriod.Error())
+		return models.AdStats{}, ad.ErrInvalidPeriod
+	}
+	if err := uc.checkOwner(ctx, userId, id, false); err != nil {
+		loggerVar.Error(err.Error())
+		return models.AdStats{}, err
+	}
+
+	days, err := uc.repo.SelectAdStats(ctx, id, from, to)
+	if err != nil {
+		loggerVar.Error("error fetching stats: " + err.Error())
+		return models.AdStats{}, err
+	}
+
+	// days without views are not stored, the dashboard gets them as zeros
+	stats := models.AdStats{From: from, To: to}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		entry := models.AdDayStats{Day: day}
+		if len(days) > 0 && truncateDay(days[0].Day).Equal(day) {
+			entry, days = days[0], days[1:]
+			entry.Day = day
+		}
+		stats.Views += entry.Views
+		stats.Impressions += entry.Impressions
+		stats.Days = append(stats.Days, entry)
+	}
+
+	loggerVar.Info("Successful")
+	return stats, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
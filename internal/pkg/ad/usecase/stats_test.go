@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad/mocks"
+	eventsMocks "github.com/K1tten2005/go_vk_intern/internal/pkg/events/mocks"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecordViews(t *testing.T) {
+	t.Run("Owner is not counted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ownerId := uuid.NewV4()
+		other := models.Ad{Id: uuid.NewV4(), UserId: uuid.NewV4()}
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().InsertAdViews(gomock.Any(), gomock.Any(), viewWindow).DoAndReturn(func(_ context.Context, views []models.AdView, _ time.Duration) error {
+			assert.Len(t, views, 1)
+			assert.Equal(t, other.Id, views[0].AdId)
+			assert.Equal(t, models.ViewKindImpression, views[0].Kind)
+			assert.Equal(t, "user:"+ownerId.String(), views[0].Viewer)
+			return nil
+		})
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		uc.RecordViews(context.Background(), models.ViewKindImpression, models.Viewer{UserId: ownerId, Key: "user:" + ownerId.String()},
+			[]models.Ad{{Id: uuid.NewV4(), UserId: ownerId}, other})
+		total, err := uc.FlushAdViews(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("Full batch wakes up the worker", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ads := make([]models.Ad, viewBatchSize+1)
+		for i := range ads {
+			ads[i] = models.Ad{Id: uuid.NewV4(), UserId: uuid.NewV4()}
+		}
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		gomock.InOrder(
+			mockRepo.EXPECT().InsertAdViews(gomock.Any(), gomock.Len(viewBatchSize), viewWindow).Return(nil),
+			mockRepo.EXPECT().InsertAdViews(gomock.Any(), gomock.Len(1), viewWindow).Return(nil),
+		)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		uc.RecordViews(context.Background(), models.ViewKindImpression, models.Viewer{Key: "anon:1"}, ads)
+
+		select {
+		case <-uc.ViewsQueued():
+		default:
+			t.Fatal("worker was not woken up")
+		}
+		total, err := uc.FlushAdViews(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, viewBatchSize+1, total)
+	})
+
+	t.Run("Nothing queued", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := CreateAdUsecase(mocks.NewMockAdRepo(ctrl), time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		total, err := uc.FlushAdViews(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, total)
+	})
+}
+
+func TestGetAdStats(t *testing.T) {
+	userId := uuid.NewV4()
+	id := uuid.NewV4()
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 7, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Missing days are zeros", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().SelectAdOwner(gomock.Any(), id).Return(userId, false, nil)
+		mockRepo.EXPECT().SelectAdStats(gomock.Any(), id, from, to).
+			Return([]models.AdDayStats{{Day: from.AddDate(0, 0, 1), Views: 3, Impressions: 10}}, nil)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		stats, err := uc.GetAdStats(context.Background(), userId, id, from.Add(15*time.Hour), to)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, stats.Views)
+		assert.Equal(t, 10, stats.Impressions)
+		assert.Equal(t, []models.AdDayStats{
+			{Day: from},
+			{Day: from.AddDate(0, 0, 1), Views: 3, Impressions: 10},
+			{Day: to},
+		}, stats.Days)
+	})
+
+	t.Run("Not the owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().SelectAdOwner(gomock.Any(), id).Return(uuid.NewV4(), false, nil)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		_, err := uc.GetAdStats(context.Background(), userId, id, from, to)
+
+		assert.ErrorIs(t, err, ad.ErrForbidden)
+	})
+
+	t.Run("Invalid period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := CreateAdUsecase(mocks.NewMockAdRepo(ctrl), time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+
+		_, err := uc.GetAdStats(context.Background(), userId, id, to, from)
+		assert.ErrorIs(t, err, ad.ErrInvalidPeriod)
+
+		_, err = uc.GetAdStats(context.Background(), userId, id, from, from.AddDate(2, 0, 0))
+		assert.ErrorIs(t, err, ad.ErrInvalidPeriod)
+	})
+
+	t.Run("Repo failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dbErr := errors.New("db is down")
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().SelectAdOwner(gomock.Any(), id).Return(userId, false, nil)
+		mockRepo.EXPECT().SelectAdStats(gomock.Any(), id, from, to).Return(nil, dbErr)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		_, err := uc.GetAdStats(context.Background(), userId, id, from, to)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
@@ -2,46 +2,688 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/K1tten2005/go_vk_intern/internal/models"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/events"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/cursor"
 	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
 	"github.com/satori/uuid"
 )
 
+// archiveBatchSize limits how many expired ads are archived by one query,
+// so the archiver never holds locks on a large part of the table.
+const archiveBatchSize = 100
+
+// maxPriceHistory bounds the number of prices returned for one ad.
+const maxPriceHistory = 100
+
 type AdUsecase struct {
 	repo ad.AdRepo
+	ttl  time.Duration
+	// mediaQueued wakes up the image check workers when an ad waits for
+	// them, see MediaQueued.
+	mediaQueued chan struct{}
+	events      events.Publisher
+	// images downloads remote images, see validateImage.
+	images ad.ImageFetcher
+	// views are saved in batches by FlushAdViews, see RecordViews.
+	views       chan models.AdView
+	viewsQueued chan struct{}
 }
 
-func CreateAdUsecase(repo ad.AdRepo) *AdUsecase {
-	return &AdUsecase{repo: repo}
+func CreateAdUsecase(repo ad.AdRepo, ttl time.Duration, publisher events.Publisher, images ad.ImageFetcher) *AdUsecase {
+	return &AdUsecase{
+		repo:        repo,
+		ttl:         ttl,
+		mediaQueued: make(chan struct{}, 1),
+		events:      publisher,
+		images:      images,
+		views:       make(chan models.AdView, viewQueueSize),
+		viewsQueued: make(chan struct{}, 1),
+	}
 }
 
 func (uc *AdUsecase) CreateAd(ctx context.Context, data models.Ad) (models.Ad, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
 	data.CreatedAt = time.Now()
+	data.ExpiresAt = data.CreatedAt.Add(uc.ttl)
 	data.Id = uuid.NewV4()
+	data.Version = 1
+	for i := range data.Images {
+		data.Images[i].Id = uuid.NewV4()
+		data.Images[i].Position = i
+		data.Images[i].IsCover = i == 0
+	}
+	if cover, ok := data.Images.Cover(); ok {
+		data.ImageURL = cover.URL
+	}
+	if data.Status == "" {
+		data.Status = models.AdStatusPublished
+	}
+	if data.Currency == "" {
+		data.Currency = models.BaseCurrency
+	}
+	if data.Status != models.AdStatusDraft && data.Status != models.AdStatusPublished {
+		loggerVar.Error(ad.ErrInvalidStatus.Error())
+		return models.Ad{}, ad.ErrInvalidStatus
+	}
+	if err := uc.validateAttributes(ctx, data); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+	if err := uc.checkUploadedImages(ctx, data.UserId, data.Images); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+	// remote images are downloaded by the workers, the ad waits for them
+	if hasRemoteImages(data.Images) {
+		data.PendingStatus = data.Status
+		data.Status = models.AdStatusPendingMedia
+	}
 
 	if err := uc.repo.InsertAd(ctx, data); err != nil {
 		loggerVar.Error(err.Error())
 		return models.Ad{}, err
 	}
+	if data.Status == models.AdStatusPendingMedia {
+		uc.notifyMedia()
+	}
 
 	loggerVar.Info("Successful")
 	return data, nil
 }
 
-func (uc *AdUsecase) GetAds(ctx context.Context, filter models.Filter) ([]models.Ad, error) {
+// checkUploadedImages makes sure uploaded images of a gallery belong to
+// userId and are not repeated. Images of other users could be linked
+// otherwise just by knowing their ids.
+func (uc *AdUsecase) checkUploadedImages(ctx context.Context, userId uuid.UUID, images models.AdImageList) error {
+	imageIds := make([]uuid.UUID, 0, len(images))
+	for _, image := range images {
+		if image.ImageId == nil {
+			continue
+		}
+		if slices.Contains(imageIds, *image.ImageId) {
+			return fmt.Errorf("%w: repeated image %s", ad.ErrInvalidAd, image.ImageId)
+		}
+		imageIds = append(imageIds, *image.ImageId)
+	}
+	if len(imageIds) == 0 {
+		return nil
+	}
+
+	count, err := uc.repo.CountUserImages(ctx, userId, imageIds)
+	if err != nil {
+		return err
+	}
+	if count != len(imageIds) {
+		return fmt.Errorf("%w: uploaded image not found", ad.ErrInvalidAd)
+	}
+	return nil
+}
+
+// validateAttributes checks attribute values of an ad against the schema of
+// its category, inherited attributes included.
+func (uc *AdUsecase) validateAttributes(ctx context.Context, data models.Ad) error {
+	schema, err := uc.repo.SelectCategorySchema(ctx, data.CategoryId)
+	if err != nil {
+		return err
+	}
+	if err := validation.ValidateAttributes(schema, data.Attributes); err != nil {
+		return fmt.Errorf("%w: %s", ad.ErrInvalidAd, err.Error())
+	}
+	return nil
+}
+
+func (uc *AdUsecase) GetAds(ctx context.Context, filter models.Filter) (models.AdList, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
+	if err := uc.checkCurrency(ctx, filter.Currency); err != nil {
+		return models.AdList{}, err
+	}
+	if err := uc.checkCategory(ctx, filter.CategoryId); err != nil {
+		return models.AdList{}, err
+	}
+
+	// One extra row tells whether there is a next page without counting.
+	limit := filter.Limit
+	filter.Limit++
+
 	ads, err := uc.repo.SelectAds(ctx, filter)
 	if err != nil {
 		loggerVar.Error("error fetching ads: " + err.Error())
-		return ads, err
+		return models.AdList{}, err
+	}
+
+	list := models.AdList{Ads: ads}
+	if len(ads) > limit {
+		list.Ads = ads[:limit]
+		list.HasNext = true
+	}
+
+	if list.HasNext && cursor.Supported(filter.SortBy) {
+		list.NextCursor, err = cursor.Encode(cursor.FromAd(list.Ads[limit-1], filter))
+		if err != nil {
+			loggerVar.Error("error encoding cursor: " + err.Error())
+			return models.AdList{}, err
+		}
+	}
+
+	return list, nil
+}
+
+// checkCurrency makes sure a filter currency has a rate. Without one every
+// price would convert to NULL and the filter would silently match nothing.
+func (uc *AdUsecase) checkCurrency(ctx context.Context, currency string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if currency == "" {
+		return nil
+	}
+	exists, err := uc.repo.CurrencyExists(ctx, currency)
+	if err != nil {
+		loggerVar.Error("error checking currency: " + err.Error())
+		return err
+	}
+	if !exists {
+		loggerVar.Error(ad.ErrUnknownCurrency.Error())
+		return ad.ErrUnknownCurrency
+	}
+	return nil
+}
+
+// checkCategory makes sure a filter category exists. An unknown category
+// would match nothing, which looks like a category without ads.
+func (uc *AdUsecase) checkCategory(ctx context.Context, categoryId uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if categoryId == uuid.Nil {
+		return nil
+	}
+	if _, err := uc.repo.SelectCategorySchema(ctx, categoryId); err != nil {
+		loggerVar.Error("error checking category: " + err.Error())
+		return err
+	}
+	return nil
+}
+
+// GetAdsPage is GetAds plus the total number of ads matching the filter.
+// The count query is skipped when the page alone tells the total.
+func (uc *AdUsecase) GetAdsPage(ctx context.Context, filter models.Filter) (models.AdList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	list, err := uc.GetAds(ctx, filter)
+	if err != nil {
+		return models.AdList{}, err
+	}
+
+	if filter.Cursor == nil && !list.HasNext && (len(list.Ads) > 0 || filter.Page == 1) {
+		list.Total = (filter.Page-1)*filter.Limit + len(list.Ads)
+		return list, nil
+	}
+
+	list.Total, err = uc.repo.CountAds(ctx, filter)
+	if err != nil {
+		loggerVar.Error("error counting ads: " + err.Error())
+		return models.AdList{}, err
 	}
 
-	return ads, nil
+	return list, nil
+}
+
+// GetAdById returns an ad visible to userId, telling whether the user saved
+// it to favorites.
+func (uc *AdUsecase) GetAdById(ctx context.Context, userId, id uuid.UUID) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	advertisement, err := uc.visibleAd(ctx, userId, id)
+	if err != nil {
+		return models.Ad{}, err
+	}
+
+	if userId != uuid.Nil {
+		advertisement.IsFavorite, err = uc.repo.SelectFavorite(ctx, userId, id)
+		if err != nil {
+			loggerVar.Error("error fetching favorite: " + err.Error())
+			return models.Ad{}, err
+		}
+	}
+
+	return advertisement, nil
+}
+
+// visibleAd returns an ad unless it is hidden from userId.
+func (uc *AdUsecase) visibleAd(ctx context.Context, userId, id uuid.UUID) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	advertisement, err := uc.repo.SelectAdById(ctx, id)
+	if err != nil {
+		loggerVar.Error("error fetching ad: " + err.Error())
+		return models.Ad{}, err
+	}
+
+	if ad.Hidden(advertisement.Status) && advertisement.UserId != userId {
+		loggerVar.Error(ad.ErrAdNotFound.Error())
+		return models.Ad{}, ad.ErrAdNotFound
+	}
+
+	return advertisement, nil
+}
+
+// GetPriceHistory returns the latest prices of an ad, the newest first. The
+// history is visible to whoever can see the ad.
+func (uc *AdUsecase) GetPriceHistory(ctx context.Context, userId, id uuid.UUID) ([]models.PriceChange, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := uc.visibleAd(ctx, userId, id); err != nil {
+		return nil, err
+	}
+
+	history, err := uc.repo.SelectPriceHistory(ctx, id, maxPriceHistory)
+	if err != nil {
+		loggerVar.Error("error fetching price history: " + err.Error())
+		return nil, err
+	}
+	return history, nil
+}
+
+func (uc *AdUsecase) UpdateAd(ctx context.Context, userId, id uuid.UUID, version int, patch models.AdPatch) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	advertisement, err := uc.repo.SelectAdById(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	if advertisement.UserId != userId {
+		loggerVar.Error(ad.ErrForbidden.Error())
+		return models.Ad{}, ad.ErrForbidden
+	}
+	if advertisement.Version != version {
+		loggerVar.Error(ad.ErrVersionMismatch.Error())
+		return models.Ad{}, ad.ErrVersionMismatch
+	}
+	oldPrice, oldCurrency := advertisement.Price, advertisement.Currency
+
+	if patch.Title != nil {
+		advertisement.Title = *patch.Title
+	}
+	if patch.Description != nil {
+		advertisement.Description = *patch.Description
+	}
+	if patch.Price != nil {
+		advertisement.Price = *patch.Price
+	}
+	if patch.Currency != nil {
+		advertisement.Currency = *patch.Currency
+	}
+	if patch.CategoryId != nil {
+		advertisement.CategoryId = *patch.CategoryId
+	}
+	if patch.Attributes != nil {
+		advertisement.Attributes = patch.Attributes
+	}
+	if patch.Tags != nil {
+		advertisement.Tags = patch.Tags
+	}
+	if patch.City != nil {
+		advertisement.City = *patch.City
+	}
+	if patch.Latitude != nil {
+		advertisement.Latitude = patch.Latitude
+	}
+	if patch.Longitude != nil {
+		advertisement.Longitude = patch.Longitude
+	}
+
+	if err := validation.ValidateAd(advertisement); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, fmt.Errorf("%w: %s", ad.ErrInvalidAd, err.Error())
+	}
+	// image_url of PATCH replaces the cover with a remote image, so an upload
+	// behind the old cover and its thumbnails are dropped; the rest of the
+	// gallery is managed by the image handlers
+	if patch.ImageURL != nil && *patch.ImageURL != advertisement.ImageURL {
+		if err := uc.validateImage(ctx, *patch.ImageURL); err != nil {
+			loggerVar.Error(err.Error())
+			return models.Ad{}, fmt.Errorf("%w: %s", ad.ErrInvalidAd, err.Error())
+		}
+		advertisement.ImageURL = *patch.ImageURL
+		for i := range advertisement.Images {
+			if advertisement.Images[i].IsCover {
+				advertisement.Images[i].URL = *patch.ImageURL
+				advertisement.Images[i].ImageId = nil
+				advertisement.Images[i].Thumbnails = nil
+			}
+		}
+	}
+	if err := uc.validateAttributes(ctx, advertisement); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+	advertisement.Sanitize()
+
+	// the previous price is kept by the database, which also converts it
+	// when the currency changes, so it comes back with the saved ad
+	advertisement, err = uc.repo.UpdateAd(ctx, advertisement, version)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+	if (advertisement.Price != oldPrice || advertisement.Currency != oldCurrency) && !ad.Hidden(advertisement.Status) {
+		uc.notifyPriceChanged(ctx, advertisement)
+	}
+
+	loggerVar.Info("Successful")
+	return advertisement, nil
+}
+
+func (uc *AdUsecase) TransitionAd(ctx context.Context, userId, id uuid.UUID, status models.AdStatus) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if !ad.ValidStatus(status) {
+		loggerVar.Error(ad.ErrInvalidStatus.Error())
+		return models.Ad{}, ad.ErrInvalidStatus
+	}
+
+	advertisement, err := uc.repo.SelectAdById(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	if advertisement.UserId != userId {
+		loggerVar.Error(ad.ErrForbidden.Error())
+		return models.Ad{}, ad.ErrForbidden
+	}
+	if !ad.CanTransition(advertisement.Status, status) {
+		err := &ad.TransitionError{From: advertisement.Status, To: status}
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	if status == models.AdStatusPublished && advertisement.ExpiresAt.Before(time.Now()) {
+		advertisement.ExpiresAt = time.Now().Add(uc.ttl)
+	}
+
+	advertisement.Version, err = uc.repo.UpdateAdStatus(ctx, id, advertisement.Status, status, advertisement.ExpiresAt)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+	advertisement.Status = status
+
+	loggerVar.Info("Successful")
+	return advertisement, nil
+}
+
+// RenewAd extends the life of an ad by the configured TTL counting from now.
+// Only ads which expire can be renewed: published and reserved ones keep
+// their status, archived ones are published again.
+func (uc *AdUsecase) RenewAd(ctx context.Context, userId, id uuid.UUID) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	advertisement, err := uc.repo.SelectAdById(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	if advertisement.UserId != userId {
+		loggerVar.Error(ad.ErrForbidden.Error())
+		return models.Ad{}, ad.ErrForbidden
+	}
+
+	status := advertisement.Status
+	switch status {
+	case models.AdStatusPublished, models.AdStatusReserved:
+	case models.AdStatusArchived:
+		status = models.AdStatusPublished
+	default:
+		err := &ad.TransitionError{From: status, To: models.AdStatusPublished}
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	expiresAt := time.Now().Add(uc.ttl)
+	advertisement.Version, err = uc.repo.UpdateAdStatus(ctx, id, advertisement.Status, status, expiresAt)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+	advertisement.Status = status
+	advertisement.ExpiresAt = expiresAt
+
+	loggerVar.Info("Successful")
+	return advertisement, nil
+}
+
+func (uc *AdUsecase) DeleteAd(ctx context.Context, userId, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.checkOwner(ctx, userId, id, false); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	if err := uc.repo.SoftDeleteAd(ctx, id); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (uc *AdUsecase) RestoreAd(ctx context.Context, userId, id uuid.UUID) (models.Ad, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.checkOwner(ctx, userId, id, true); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	if err := uc.repo.RestoreAd(ctx, id); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	advertisement, err := uc.repo.SelectAdById(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Ad{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return advertisement, nil
+}
+
+func (uc *AdUsecase) PurgeDeletedAds(ctx context.Context, retention time.Duration) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	purged, err := uc.repo.PurgeDeletedAds(ctx, time.Now().Add(-retention))
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// ArchiveExpiredAds archives published and reserved ads whose life is over.
+// It works in batches until nothing is left or ctx is cancelled.
+func (uc *AdUsecase) ArchiveExpiredAds(ctx context.Context) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var total int64
+	now := time.Now()
+	for ctx.Err() == nil {
+		archived, err := uc.repo.ArchiveExpiredAds(ctx, now, archiveBatchSize)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return total, err
+		}
+		total += archived
+		if archived < archiveBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		loggerVar.Info("Successful", slog.Int64("archived", total))
+	}
+	return total, nil
+}
+
+// checkOwner makes sure the ad exists, belongs to userId and is in the
+// expected deletion state. Deleted ads are invisible to everything except
+// restore, so asking to delete them again is reported as not found.
+func (uc *AdUsecase) checkOwner(ctx context.Context, userId, id uuid.UUID, wantDeleted bool) error {
+	ownerId, deleted, err := uc.repo.SelectAdOwner(ctx, id)
+	if err != nil {
+		return err
+	}
+	if deleted && !wantDeleted {
+		return ad.ErrAdNotFound
+	}
+	if ownerId != userId {
+		return ad.ErrForbidden
+	}
+	if !deleted && wantDeleted {
+		return ad.ErrAdNotDeleted
+	}
+	return nil
+}
+
+func (uc *AdUsecase) GetPopularTags(ctx context.Context, limit int) (models.TagCountList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tags, err := uc.repo.SelectPopularTags(ctx, limit)
+	if err != nil {
+		loggerVar.Error("error fetching tags: " + err.Error())
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// AddAdImage appends an image to the gallery of an ad of userId and returns
+// the updated gallery.
+func (uc *AdUsecase) AddAdImage(ctx context.Context, userId, id uuid.UUID, image models.AdImage) (models.AdImageList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.checkOwner(ctx, userId, id, false); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	if image.ImageId != nil {
+		if err := uc.checkUploadedImages(ctx, userId, models.AdImageList{image}); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+	} else if err := uc.validateImage(ctx, image.URL); err != nil {
+		// the image is fetched only for the owner, nobody else can make
+		// the server download arbitrary URLs
+		loggerVar.Error(err.Error())
+		return nil, fmt.Errorf("%w: %s", ad.ErrInvalidAd, err.Error())
+	}
+
+	image.Id = uuid.NewV4()
+	if err := uc.repo.InsertAdImage(ctx, id, image, validation.MaxImagesPerAd); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	images, err := uc.repo.SelectAdImages(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return images, nil
+}
+
+// DeleteAdImage removes an image from the gallery. The last image can not be
+// removed, an ad always has a cover.
+func (uc *AdUsecase) DeleteAdImage(ctx context.Context, userId, id, imageId uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.checkOwner(ctx, userId, id, false); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	// the repo refuses to remove the last image
+	if err := uc.repo.DeleteAdImage(ctx, id, imageId); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// ReorderAdImages sets the order of the whole gallery. imageIds must contain
+// every image of the ad exactly once, coverId defaults to the current cover.
+func (uc *AdUsecase) ReorderAdImages(ctx context.Context, userId, id uuid.UUID, imageIds []uuid.UUID, coverId uuid.UUID) (models.AdImageList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.checkOwner(ctx, userId, id, false); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	images, err := uc.repo.SelectAdImages(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	current := make(map[uuid.UUID]bool, len(images))
+	for _, image := range images {
+		current[image.Id] = true
+	}
+	if len(imageIds) != len(images) {
+		loggerVar.Error(ad.ErrInvalidOrder.Error())
+		return nil, ad.ErrInvalidOrder
+	}
+	for _, imageId := range imageIds {
+		if !current[imageId] {
+			loggerVar.Error(ad.ErrInvalidOrder.Error())
+			return nil, ad.ErrInvalidOrder
+		}
+		// a repeated id would leave another image out
+		delete(current, imageId)
+	}
+
+	if coverId == uuid.Nil {
+		cover, _ := images.Cover()
+		coverId = cover.Id
+	}
+	if !slices.Contains(imageIds, coverId) {
+		loggerVar.Error(ad.ErrImageNotFound.Error())
+		return nil, ad.ErrImageNotFound
+	}
+
+	if err := uc.repo.ReorderAdImages(ctx, id, imageIds, coverId); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	images, err = uc.repo.SelectAdImages(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return images, nil
 }
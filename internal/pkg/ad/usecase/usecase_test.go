@@ -0,0 +1,700 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad/mocks"
+	eventsMocks "github.com/K1tten2005/go_vk_intern/internal/pkg/events/mocks"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/cursor"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/fetcher"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAd(t *testing.T) {
+	categoryId := uuid.NewV4()
+	minRooms := 1.0
+	schema := models.AttributeSchemaList{
+		{Name: "rooms", Type: models.AttributeInt, Required: true, Min: &minRooms},
+	}
+
+	tests := []struct {
+		name        string
+		attrs       map[string]interface{}
+		schemaErr   error
+		inserted    bool
+		expectedErr error
+	}{
+		{
+			name:     "Valid attributes",
+			attrs:    map[string]interface{}{"rooms": 2.0},
+			inserted: true,
+		},
+		{
+			name:        "Missing required attribute",
+			attrs:       nil,
+			expectedErr: ad.ErrInvalidAd,
+		},
+		{
+			name:        "Out of range",
+			attrs:       map[string]interface{}{"rooms": 0.0},
+			expectedErr: ad.ErrInvalidAd,
+		},
+		{
+			name:        "Unknown category",
+			attrs:       map[string]interface{}{"rooms": 2.0},
+			schemaErr:   ad.ErrCategoryNotFound,
+			expectedErr: ad.ErrCategoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectCategorySchema(gomock.Any(), categoryId).Return(schema, tt.schemaErr)
+			if tt.inserted {
+				mockRepo.EXPECT().InsertAd(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			_, err := uc.CreateAd(context.Background(), models.Ad{CategoryId: categoryId, Attributes: tt.attrs})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestArchiveExpiredAds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAdRepo(ctrl)
+	gomock.InOrder(
+		mockRepo.EXPECT().ArchiveExpiredAds(gomock.Any(), gomock.Any(), archiveBatchSize).Return(int64(archiveBatchSize), nil),
+		mockRepo.EXPECT().ArchiveExpiredAds(gomock.Any(), gomock.Any(), archiveBatchSize).Return(int64(7), nil),
+	)
+
+	uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+	archived, err := uc.ArchiveExpiredAds(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(archiveBatchSize+7), archived)
+}
+
+func TestRenewAd(t *testing.T) {
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+	expired := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		stored         models.Ad
+		repoMocker     func(*mocks.MockAdRepo)
+		expectedStatus models.AdStatus
+		expectedErr    error
+	}{
+		{
+			name:        "Not owner",
+			stored:      models.Ad{Id: id, UserId: uuid.NewV4(), Status: models.AdStatusPublished},
+			repoMocker:  func(*mocks.MockAdRepo) {},
+			expectedErr: ad.ErrForbidden,
+		},
+		{
+			name:        "Sold ad",
+			stored:      models.Ad{Id: id, UserId: userId, Status: models.AdStatusSold},
+			repoMocker:  func(*mocks.MockAdRepo) {},
+			expectedErr: &ad.TransitionError{From: models.AdStatusSold, To: models.AdStatusPublished},
+		},
+		{
+			name:        "Draft",
+			stored:      models.Ad{Id: id, UserId: userId, Status: models.AdStatusDraft},
+			repoMocker:  func(*mocks.MockAdRepo) {},
+			expectedErr: &ad.TransitionError{From: models.AdStatusDraft, To: models.AdStatusPublished},
+		},
+		{
+			name:        "Rejected ad",
+			stored:      models.Ad{Id: id, UserId: userId, Status: models.AdStatusRejected},
+			repoMocker:  func(*mocks.MockAdRepo) {},
+			expectedErr: &ad.TransitionError{From: models.AdStatusRejected, To: models.AdStatusPublished},
+		},
+		{
+			name:        "Ad waiting for media check",
+			stored:      models.Ad{Id: id, UserId: userId, Status: models.AdStatusPendingMedia},
+			repoMocker:  func(*mocks.MockAdRepo) {},
+			expectedErr: &ad.TransitionError{From: models.AdStatusPendingMedia, To: models.AdStatusPublished},
+		},
+		{
+			name:   "Published ad keeps status",
+			stored: models.Ad{Id: id, UserId: userId, Status: models.AdStatusPublished, ExpiresAt: expired},
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().UpdateAdStatus(gomock.Any(), id, models.AdStatusPublished, models.AdStatusPublished, gomock.Any()).Return(3, nil)
+			},
+			expectedStatus: models.AdStatusPublished,
+		},
+		{
+			name:   "Archived ad is published again",
+			stored: models.Ad{Id: id, UserId: userId, Status: models.AdStatusArchived, ExpiresAt: expired},
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().UpdateAdStatus(gomock.Any(), id, models.AdStatusArchived, models.AdStatusPublished, gomock.Any()).Return(3, nil)
+			},
+			expectedStatus: models.AdStatusPublished,
+		},
+		{
+			name:   "Reserved ad keeps status",
+			stored: models.Ad{Id: id, UserId: userId, Status: models.AdStatusReserved, ExpiresAt: expired},
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().UpdateAdStatus(gomock.Any(), id, models.AdStatusReserved, models.AdStatusReserved, gomock.Any()).Return(3, nil)
+			},
+			expectedStatus: models.AdStatusReserved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAdById(gomock.Any(), id).Return(tt.stored, nil)
+			tt.repoMocker(mockRepo)
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			renewed, err := uc.RenewAd(context.Background(), userId, id)
+
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.expectedStatus, renewed.Status)
+				assert.True(t, renewed.ExpiresAt.After(time.Now()))
+			}
+		})
+	}
+}
+
+func TestGetAds(t *testing.T) {
+	ads := []models.Ad{
+		{Id: uuid.NewV4(), Price: 100, BasePrice: 100},
+		{Id: uuid.NewV4(), Price: 200, BasePrice: 200},
+		{Id: uuid.NewV4(), Price: 300, BasePrice: 300},
+	}
+
+	tests := []struct {
+		name           string
+		filter         models.Filter
+		stored         []models.Ad
+		expectedLen    int
+		expectedNext   bool
+		expectedCursor bool
+	}{
+		{
+			name:           "Has next page",
+			filter:         models.Filter{Page: 1, Limit: 2, SortBy: "price", Order: "asc"},
+			stored:         ads,
+			expectedLen:    2,
+			expectedNext:   true,
+			expectedCursor: true,
+		},
+		{
+			name:        "Last page",
+			filter:      models.Filter{Page: 1, Limit: 5, SortBy: "price", Order: "asc"},
+			stored:      ads,
+			expectedLen: 3,
+		},
+		{
+			name:         "No cursor for relevance",
+			filter:       models.Filter{Page: 1, Limit: 2, SortBy: "relevance", Order: "desc", Query: "test"},
+			stored:       ads,
+			expectedLen:  2,
+			expectedNext: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.Filter) ([]models.Ad, error) {
+				assert.Equal(t, tt.filter.Limit+1, f.Limit)
+				return tt.stored, nil
+			})
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			list, err := uc.GetAds(context.Background(), tt.filter)
+
+			assert.NoError(t, err)
+			assert.Len(t, list.Ads, tt.expectedLen)
+			assert.Equal(t, tt.expectedNext, list.HasNext)
+			assert.Equal(t, tt.expectedCursor, list.NextCursor != "")
+			if tt.expectedCursor {
+				c, err := cursor.Decode(list.NextCursor)
+				assert.NoError(t, err)
+				assert.Equal(t, ads[1].Id, c.Id)
+				assert.Equal(t, ads[1].BasePrice, c.Price)
+			}
+		})
+	}
+}
+
+func TestGetAdsCategory(t *testing.T) {
+	categoryId := uuid.NewV4()
+
+	t.Run("Unknown category", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().SelectCategorySchema(gomock.Any(), categoryId).Return(nil, ad.ErrCategoryNotFound)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		_, err := uc.GetAds(context.Background(), models.Filter{Page: 1, Limit: 10, CategoryId: categoryId})
+
+		assert.ErrorIs(t, err, ad.ErrCategoryNotFound)
+	})
+
+	t.Run("Known category", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().SelectCategorySchema(gomock.Any(), categoryId).Return(nil, nil)
+		mockRepo.EXPECT().SelectAds(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		_, err := uc.GetAds(context.Background(), models.Filter{Page: 1, Limit: 10, CategoryId: categoryId})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetAdsCurrency(t *testing.T) {
+	t.Run("Cursor keeps the base price and the currency", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		converted := []int{10000, 20000}
+		stored := []models.Ad{
+			{Id: uuid.NewV4(), Price: 10000, Currency: "USD", BasePrice: 925000, DisplayPrice: &converted[0]},
+			{Id: uuid.NewV4(), Price: 20000, Currency: "USD", BasePrice: 1850000, DisplayPrice: &converted[1]},
+		}
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().CurrencyExists(gomock.Any(), "USD").Return(true, nil)
+		mockRepo.EXPECT().SelectAds(gomock.Any(), gomock.Any()).Return(stored, nil)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		list, err := uc.GetAds(context.Background(), models.Filter{Page: 1, Limit: 1, SortBy: "price", Order: "asc", Currency: "USD"})
+
+		assert.NoError(t, err)
+		c, err := cursor.Decode(list.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, 925000, c.Price)
+		assert.Equal(t, "USD", c.Currency)
+	})
+
+	t.Run("Unknown currency", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockAdRepo(ctrl)
+		mockRepo.EXPECT().CurrencyExists(gomock.Any(), "XXX").Return(false, nil)
+
+		uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+		_, err := uc.GetAds(context.Background(), models.Filter{Page: 1, Limit: 10, Currency: "XXX"})
+
+		assert.ErrorIs(t, err, ad.ErrUnknownCurrency)
+	})
+}
+
+func TestGetAdsPage(t *testing.T) {
+	ads := []models.Ad{{Id: uuid.NewV4()}, {Id: uuid.NewV4()}, {Id: uuid.NewV4()}}
+
+	tests := []struct {
+		name          string
+		filter        models.Filter
+		stored        []models.Ad
+		countCalled   bool
+		expectedTotal int
+	}{
+		{
+			name:          "Last page is counted without query",
+			filter:        models.Filter{Page: 3, Limit: 5, SortBy: "price", Order: "asc"},
+			stored:        ads,
+			expectedTotal: 13,
+		},
+		{
+			name:          "Empty first page",
+			filter:        models.Filter{Page: 1, Limit: 5, SortBy: "price", Order: "asc"},
+			stored:        []models.Ad{},
+			expectedTotal: 0,
+		},
+		{
+			name:          "Middle page",
+			filter:        models.Filter{Page: 1, Limit: 2, SortBy: "price", Order: "asc"},
+			stored:        ads,
+			countCalled:   true,
+			expectedTotal: 40,
+		},
+		{
+			name:          "Page past the end",
+			filter:        models.Filter{Page: 9, Limit: 5, SortBy: "price", Order: "asc"},
+			stored:        []models.Ad{},
+			countCalled:   true,
+			expectedTotal: 40,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAds(gomock.Any(), gomock.Any()).Return(tt.stored, nil)
+			if tt.countCalled {
+				mockRepo.EXPECT().CountAds(gomock.Any(), tt.filter).Return(40, nil)
+			}
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			list, err := uc.GetAdsPage(context.Background(), tt.filter)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedTotal, list.Total)
+		})
+	}
+}
+
+func TestReorderAdImages(t *testing.T) {
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+	first, second, third := uuid.NewV4(), uuid.NewV4(), uuid.NewV4()
+	gallery := models.AdImageList{
+		{Id: first, Position: 0, IsCover: true},
+		{Id: second, Position: 1},
+		{Id: third, Position: 2},
+	}
+
+	tests := []struct {
+		name          string
+		imageIds      []uuid.UUID
+		coverId       uuid.UUID
+		expectedCover uuid.UUID
+		expectedErr   error
+	}{
+		{
+			name:          "Cover is kept",
+			imageIds:      []uuid.UUID{third, first, second},
+			expectedCover: first,
+		},
+		{
+			name:          "New cover",
+			imageIds:      []uuid.UUID{third, first, second},
+			coverId:       third,
+			expectedCover: third,
+		},
+		{
+			name:        "Missing image",
+			imageIds:    []uuid.UUID{third, first},
+			expectedErr: ad.ErrInvalidOrder,
+		},
+		{
+			name:        "Repeated image",
+			imageIds:    []uuid.UUID{third, first, first},
+			expectedErr: ad.ErrInvalidOrder,
+		},
+		{
+			name:        "Unknown cover",
+			imageIds:    []uuid.UUID{third, first, second},
+			coverId:     uuid.NewV4(),
+			expectedErr: ad.ErrImageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAdOwner(gomock.Any(), id).Return(userId, false, nil)
+			mockRepo.EXPECT().SelectAdImages(gomock.Any(), id).Return(gallery, nil)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().ReorderAdImages(gomock.Any(), id, tt.imageIds, tt.expectedCover).Return(nil)
+				mockRepo.EXPECT().SelectAdImages(gomock.Any(), id).Return(gallery, nil)
+			}
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			_, err := uc.ReorderAdImages(context.Background(), userId, id, tt.imageIds, tt.coverId)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestDeleteAdImage(t *testing.T) {
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+	imageId := uuid.NewV4()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockAdRepo(ctrl)
+	mockRepo.EXPECT().SelectAdOwner(gomock.Any(), id).Return(userId, false, nil)
+	mockRepo.EXPECT().DeleteAdImage(gomock.Any(), id, imageId).Return(ad.ErrLastImage)
+
+	uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+	err := uc.DeleteAdImage(context.Background(), userId, id, imageId)
+
+	assert.ErrorIs(t, err, ad.ErrLastImage)
+}
+
+func TestAddAdImageUploaded(t *testing.T) {
+	id := uuid.NewV4()
+	userId := uuid.NewV4()
+	imageId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(*mocks.MockAdRepo)
+		wantErr    error
+	}{
+		{
+			name: "Own image",
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().CountUserImages(gomock.Any(), userId, []uuid.UUID{imageId}).Return(1, nil)
+				mockRepo.EXPECT().InsertAdImage(gomock.Any(), id, gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().SelectAdImages(gomock.Any(), id).Return(models.AdImageList{{ImageId: &imageId}}, nil)
+			},
+		},
+		{
+			name: "Image of another user",
+			repoMocker: func(mockRepo *mocks.MockAdRepo) {
+				mockRepo.EXPECT().CountUserImages(gomock.Any(), userId, []uuid.UUID{imageId}).Return(0, nil)
+			},
+			wantErr: ad.ErrInvalidAd,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAdOwner(gomock.Any(), id).Return(userId, false, nil)
+			tt.repoMocker(mockRepo)
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			_, err := uc.AddAdImage(context.Background(), userId, id, models.AdImage{URL: "/api/images/" + imageId.String(), ImageId: &imageId})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetPriceHistory(t *testing.T) {
+	ownerId := uuid.NewV4()
+	id := uuid.NewV4()
+	history := []models.PriceChange{{Price: 900, Currency: "RUB"}, {Price: 1000, Currency: "RUB"}}
+
+	tests := []struct {
+		name        string
+		userId      uuid.UUID
+		status      models.AdStatus
+		expectedErr error
+	}{
+		{name: "Published", userId: uuid.Nil, status: models.AdStatusPublished},
+		{name: "Own draft", userId: ownerId, status: models.AdStatusDraft},
+		{name: "Draft of another user", userId: uuid.NewV4(), status: models.AdStatusDraft, expectedErr: ad.ErrAdNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAdById(gomock.Any(), id).Return(models.Ad{Id: id, UserId: ownerId, Status: tt.status}, nil)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().SelectPriceHistory(gomock.Any(), id, maxPriceHistory).Return(history, nil)
+			}
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			result, err := uc.GetPriceHistory(context.Background(), tt.userId, id)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, history, result)
+			}
+		})
+	}
+}
+
+func TestGetAdByIdFavorite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userId := uuid.NewV4()
+	id := uuid.NewV4()
+	mockRepo := mocks.NewMockAdRepo(ctrl)
+	mockRepo.EXPECT().SelectAdById(gomock.Any(), id).Return(models.Ad{Id: id, Status: models.AdStatusPublished}, nil).Times(2)
+	mockRepo.EXPECT().SelectFavorite(gomock.Any(), userId, id).Return(true, nil)
+
+	uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+
+	result, err := uc.GetAdById(context.Background(), userId, id)
+	assert.NoError(t, err)
+	assert.True(t, result.IsFavorite)
+
+	// anonymous users have no favorites to look up
+	result, err = uc.GetAdById(context.Background(), uuid.Nil, id)
+	assert.NoError(t, err)
+	assert.False(t, result.IsFavorite)
+}
+
+func TestAddFavorite(t *testing.T) {
+	userId := uuid.NewV4()
+	id := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		status      models.AdStatus
+		expectedErr error
+	}{
+		{name: "Published", status: models.AdStatusPublished},
+		{name: "Sold", status: models.AdStatusSold},
+		{name: "Draft of another user", status: models.AdStatusDraft, expectedErr: ad.ErrAdNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAdById(gomock.Any(), id).Return(models.Ad{Id: id, UserId: uuid.NewV4(), Status: tt.status}, nil)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().InsertFavorite(gomock.Any(), userId, id).Return(nil)
+			}
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mocks.NewMockImageFetcher(ctrl))
+			err := uc.AddFavorite(context.Background(), userId, id)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestUpdateAdCover(t *testing.T) {
+	ownerId := uuid.NewV4()
+	id := uuid.NewV4()
+	categoryId := uuid.NewV4()
+	imageId := uuid.NewV4()
+	link := "https://example.com/new.jpg"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	current := models.Ad{
+		Id: id, UserId: ownerId, CategoryId: categoryId, Title: "Велосипед", Description: "Почти новый",
+		Price: 200000, Currency: models.BaseCurrency, Version: 1, Status: models.AdStatusPublished,
+		ImageURL: "/images/" + imageId.String(),
+		Images: models.AdImageList{
+			{URL: "/images/" + imageId.String(), ImageId: &imageId, IsCover: true},
+			{URL: "https://example.com/b.jpg", Position: 1},
+		},
+	}
+
+	mockRepo := mocks.NewMockAdRepo(ctrl)
+	mockRepo.EXPECT().SelectAdById(gomock.Any(), id).Return(current, nil)
+	mockRepo.EXPECT().SelectCategorySchema(gomock.Any(), categoryId).Return(nil, nil)
+	mockRepo.EXPECT().UpdateAd(gomock.Any(), gomock.Any(), 1).DoAndReturn(func(_ context.Context, saved models.Ad, _ int) (models.Ad, error) {
+		saved.Version = 2
+		return saved, nil
+	})
+	mockFetcher := mocks.NewMockImageFetcher(ctrl)
+	mockFetcher.EXPECT().Fetch(gomock.Any(), link).Return(fetcher.Info{}, nil)
+
+	uc := CreateAdUsecase(mockRepo, time.Hour, eventsMocks.NewMockPublisher(ctrl), mockFetcher)
+	updated, err := uc.UpdateAd(context.Background(), ownerId, id, 1, models.AdPatch{ImageURL: &link})
+
+	assert.NoError(t, err)
+	assert.Equal(t, link, updated.ImageURL)
+	assert.Equal(t, link, updated.Images[0].URL)
+	assert.Nil(t, updated.Images[0].ImageId)
+	assert.Equal(t, "https://example.com/b.jpg", updated.Images[1].URL)
+}
+
+func TestUpdateAdPriceChanged(t *testing.T) {
+	ownerId := uuid.NewV4()
+	fanId := uuid.NewV4()
+	id := uuid.NewV4()
+	categoryId := uuid.NewV4()
+	newPrice := 150000
+	newTitle := "Горный велосипед"
+	previousPrice := 200000
+
+	tests := []struct {
+		name      string
+		status    models.AdStatus
+		patch     models.AdPatch
+		wantEvent bool
+	}{
+		{name: "Price went down", status: models.AdStatusPublished, patch: models.AdPatch{Price: &newPrice}, wantEvent: true},
+		{name: "Title only", status: models.AdStatusPublished, patch: models.AdPatch{Title: &newTitle}},
+		{name: "Draft", status: models.AdStatusDraft, patch: models.AdPatch{Price: &newPrice}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := models.Ad{
+				Id: id, UserId: ownerId, CategoryId: categoryId, Title: "Велосипед", Description: "Почти новый",
+				Price: 200000, Currency: models.BaseCurrency, Version: 1, Status: tt.status,
+			}
+
+			mockRepo := mocks.NewMockAdRepo(ctrl)
+			mockRepo.EXPECT().SelectAdById(gomock.Any(), id).Return(current, nil)
+			mockRepo.EXPECT().SelectCategorySchema(gomock.Any(), categoryId).Return(nil, nil)
+			mockRepo.EXPECT().UpdateAd(gomock.Any(), gomock.Any(), 1).DoAndReturn(func(_ context.Context, saved models.Ad, _ int) (models.Ad, error) {
+				saved.Version = 2
+				if saved.Price != current.Price {
+					saved.PreviousPrice = &previousPrice
+				}
+				return saved, nil
+			})
+			mockEvents := eventsMocks.NewMockPublisher(ctrl)
+			if tt.wantEvent {
+				previous := models.Money(previousPrice)
+				mockRepo.EXPECT().SelectFavoriteUsers(gomock.Any(), id, ownerId).Return([]uuid.UUID{fanId}, nil)
+				mockEvents.EXPECT().Publish(gomock.Any(), fanId, models.Event{
+					Type: models.EventPriceChanged,
+					Data: models.PriceChangedEvent{
+						AdId:          id,
+						Title:         "Велосипед",
+						Price:         models.Money(newPrice),
+						Currency:      models.BaseCurrency,
+						PriceDropped:  true,
+						PreviousPrice: &previous,
+					},
+				})
+			}
+
+			uc := CreateAdUsecase(mockRepo, time.Hour, mockEvents, mocks.NewMockImageFetcher(ctrl))
+			_, err := uc.UpdateAd(context.Background(), ownerId, id, 1, tt.patch)
+
+			assert.NoError(t, err)
+		})
+	}
+}
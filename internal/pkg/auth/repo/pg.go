@@ -50,6 +50,7 @@ func (repo *AuthRepo) SelectUserByLogin(ctx context.Context, login string) (mode
 	if err := repo.db.QueryRow(ctx, selectUserByLogin, login).Scan(
 		&resultUser.Id,
 		&resultUser.PasswordHash,
+		&resultUser.IsAdmin,
 	); err != nil {
 		loggerVar.Error(err.Error())
 		return models.User{}, err
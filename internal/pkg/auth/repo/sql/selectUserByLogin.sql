@@ -1 +1 @@
-SELECT id, password_hash FROM users WHERE login = $1
\ No newline at end of file
+SELECT id, password_hash, is_admin FROM users WHERE login = $1
\ No newline at end of file
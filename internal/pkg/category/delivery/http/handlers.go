@@ -0,0 +1,206 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/category"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+type CategoryHandler struct {
+	uc category.CategoryUsecase
+}
+
+func CreateCategoryHandler(uc category.CategoryUsecase) *CategoryHandler {
+	return &CategoryHandler{uc: uc}
+}
+
+func (h *CategoryHandler) GetCategories(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	tree, err := h.uc.GetCategoryTree(r.Context())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusInternalServerError)
+		sendErr.SendError(w, "failed to load categories", http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(tree)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+// GetCategory returns one category with the attribute schema ads in it must
+// follow, inherited attributes included.
+func (h *CategoryHandler) GetCategory(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseCategoryId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	c, err := h.uc.GetCategory(r.Context(), id)
+	if err != nil {
+		sendCategoryError(w, loggerVar, err)
+		return
+	}
+
+	sendCategory(w, loggerVar, c, http.StatusOK)
+}
+
+func (h *CategoryHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	data, ok := parseCategoryReq(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	created, err := h.uc.CreateCategory(r.Context(), data)
+	if err != nil {
+		sendCategoryError(w, loggerVar, err)
+		return
+	}
+
+	sendCategory(w, loggerVar, created, http.StatusCreated)
+}
+
+// UpdateCategory replaces the name, the parent and the attribute schema of a
+// category. A request without parent_id moves the category to the root.
+func (h *CategoryHandler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseCategoryId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	data, ok := parseCategoryReq(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	data.Id = id
+
+	updated, err := h.uc.UpdateCategory(r.Context(), data)
+	if err != nil {
+		sendCategoryError(w, loggerVar, err)
+		return
+	}
+
+	sendCategory(w, loggerVar, updated, http.StatusOK)
+}
+
+func (h *CategoryHandler) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseCategoryId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.DeleteCategory(r.Context(), id); err != nil {
+		sendCategoryError(w, loggerVar, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func parseCategoryId(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (uuid.UUID, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing category id: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid category id", http.StatusBadRequest)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func parseCategoryReq(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (models.Category, bool) {
+	var req models.CategoryReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return models.Category{}, false
+	}
+
+	if !validation.ValidCategoryName(req.Name) {
+		logger.LogHandlerError(loggerVar, category.ErrInvalidCategory, http.StatusBadRequest)
+		sendErr.SendError(w, "invalid category name", http.StatusBadRequest)
+		return models.Category{}, false
+	}
+
+	if err := validation.ValidateAttributeSchema(req.Attributes); err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		sendErr.SendError(w, err.Error(), http.StatusBadRequest)
+		return models.Category{}, false
+	}
+
+	data := models.Category{Name: req.Name, Attributes: req.Attributes}
+	if req.ParentId != nil {
+		data.ParentId = *req.ParentId
+	}
+	data.Sanitize()
+	return data, true
+}
+
+func sendCategory(w http.ResponseWriter, loggerVar *slog.Logger, c models.Category, statusCode int) {
+	resp := models.CategoryResp{Id: c.Id, Name: c.Name, Attributes: c.Attributes}
+	if c.ParentId != uuid.Nil {
+		resp.ParentId = &c.ParentId
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+// sendCategoryError maps errors returned by the category usecase to HTTP
+// statuses.
+func sendCategoryError(w http.ResponseWriter, loggerVar *slog.Logger, err error) {
+	var statusCode int
+	switch {
+	case errors.Is(err, category.ErrParentNotFound), errors.Is(err, category.ErrCategoryCycle):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, category.ErrCategoryNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, category.ErrCategoryExists), errors.Is(err, category.ErrCategoryInUse):
+		statusCode = http.StatusConflict
+	case errors.Is(err, category.ErrCreatingCategory), errors.Is(err, category.ErrUpdatingCategory), errors.Is(err, category.ErrDeletingCategory):
+		statusCode = http.StatusInternalServerError
+	default:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "unknown error", http.StatusInternalServerError)
+		return
+	}
+
+	logger.LogHandlerError(loggerVar, err, statusCode)
+	sendErr.SendError(w, err.Error(), statusCode)
+}
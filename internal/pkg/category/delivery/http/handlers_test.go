@@ -0,0 +1,110 @@
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/category"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/category/mocks"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCategory(t *testing.T) {
+	parentId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		body           string
+		usecaseMocker  func(*mocks.MockCategoryUsecase)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"name":"Автомобили","parent_id":"` + parentId.String() + `"}`,
+			usecaseMocker: func(mockUsecase *mocks.MockCategoryUsecase) {
+				mockUsecase.EXPECT().CreateCategory(gomock.Any(), models.Category{Name: "Автомобили", ParentId: parentId}).
+					Return(models.Category{Id: uuid.NewV4(), Name: "Автомобили", ParentId: parentId}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Empty name",
+			body:           `{"name":""}`,
+			usecaseMocker:  func(*mocks.MockCategoryUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Duplicate name",
+			body: `{"name":"Транспорт"}`,
+			usecaseMocker: func(mockUsecase *mocks.MockCategoryUsecase) {
+				mockUsecase.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(models.Category{}, category.ErrCategoryExists)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "Unknown parent",
+			body: `{"name":"Автомобили","parent_id":"` + parentId.String() + `"}`,
+			usecaseMocker: func(mockUsecase *mocks.MockCategoryUsecase) {
+				mockUsecase.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(models.Category{}, category.ErrParentNotFound)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockCategoryUsecase(ctrl)
+			tt.usecaseMocker(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/categories", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+			handler := CreateCategoryHandler(mockUsecase)
+
+			handler.CreateCategory(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestDeleteCategory(t *testing.T) {
+	id := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{name: "Success", expectedStatus: http.StatusNoContent},
+		{name: "In use", err: category.ErrCategoryInUse, expectedStatus: http.StatusConflict},
+		{name: "Not found", err: category.ErrCategoryNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockCategoryUsecase(ctrl)
+			mockUsecase.EXPECT().DeleteCategory(gomock.Any(), id).Return(tt.err)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/categories/"+id.String(), nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			rr := httptest.NewRecorder()
+			handler := CreateCategoryHandler(mockUsecase)
+
+			handler.DeleteCategory(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
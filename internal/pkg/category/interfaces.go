@@ -0,0 +1,36 @@
+package category
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrInvalidCategory  = errors.New("invalid category")
+	ErrCategoryExists   = errors.New("category with this name already exists")
+	ErrCategoryCycle    = errors.New("category can not be moved under itself")
+	ErrCategoryInUse    = errors.New("category has subcategories or ads")
+	ErrCreatingCategory = errors.New("category creation error")
+	ErrUpdatingCategory = errors.New("category update error")
+	ErrDeletingCategory = errors.New("category deletion error")
+	ErrParentNotFound   = errors.New("parent category not found")
+)
+
+type CategoryUsecase interface {
+	GetCategoryTree(ctx context.Context) (models.CategoryRespList, error)
+	GetCategory(ctx context.Context, id uuid.UUID) (models.Category, error)
+	CreateCategory(ctx context.Context, data models.Category) (models.Category, error)
+	UpdateCategory(ctx context.Context, data models.Category) (models.Category, error)
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+}
+
+type CategoryRepo interface {
+	SelectCategories(ctx context.Context) ([]models.Category, error)
+	InsertCategory(ctx context.Context, data models.Category) error
+	UpdateCategory(ctx context.Context, data models.Category) error
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+}
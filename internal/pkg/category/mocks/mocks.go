@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/category/interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/pkg/category/interfaces.go -destination=internal/pkg/category/mocks/mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/go_vk_intern/internal/models"
+	uuid "github.com/satori/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCategoryUsecase is a mock of CategoryUsecase interface.
+type MockCategoryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCategoryUsecaseMockRecorder is the mock recorder for MockCategoryUsecase.
+type MockCategoryUsecaseMockRecorder struct {
+	mock *MockCategoryUsecase
+}
+
+// NewMockCategoryUsecase creates a new mock instance.
+func NewMockCategoryUsecase(ctrl *gomock.Controller) *MockCategoryUsecase {
+	mock := &MockCategoryUsecase{ctrl: ctrl}
+	mock.recorder = &MockCategoryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryUsecase) EXPECT() *MockCategoryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryUsecase) CreateCategory(ctx context.Context, data models.Category) (models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, data)
+	ret0, _ := ret[0].(models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryUsecaseMockRecorder) CreateCategory(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryUsecase)(nil).CreateCategory), ctx, data)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryUsecase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryUsecaseMockRecorder) DeleteCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryUsecase)(nil).DeleteCategory), ctx, id)
+}
+
+// GetCategory mocks base method.
+func (m *MockCategoryUsecase) GetCategory(ctx context.Context, id uuid.UUID) (models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, id)
+	ret0, _ := ret[0].(models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockCategoryUsecaseMockRecorder) GetCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockCategoryUsecase)(nil).GetCategory), ctx, id)
+}
+
+// GetCategoryTree mocks base method.
+func (m *MockCategoryUsecase) GetCategoryTree(ctx context.Context) (models.CategoryRespList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTree", ctx)
+	ret0, _ := ret[0].(models.CategoryRespList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTree indicates an expected call of GetCategoryTree.
+func (mr *MockCategoryUsecaseMockRecorder) GetCategoryTree(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTree", reflect.TypeOf((*MockCategoryUsecase)(nil).GetCategoryTree), ctx)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryUsecase) UpdateCategory(ctx context.Context, data models.Category) (models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, data)
+	ret0, _ := ret[0].(models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryUsecaseMockRecorder) UpdateCategory(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryUsecase)(nil).UpdateCategory), ctx, data)
+}
+
+// MockCategoryRepo is a mock of CategoryRepo interface.
+type MockCategoryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockCategoryRepoMockRecorder is the mock recorder for MockCategoryRepo.
+type MockCategoryRepoMockRecorder struct {
+	mock *MockCategoryRepo
+}
+
+// NewMockCategoryRepo creates a new mock instance.
+func NewMockCategoryRepo(ctrl *gomock.Controller) *MockCategoryRepo {
+	mock := &MockCategoryRepo{ctrl: ctrl}
+	mock.recorder = &MockCategoryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryRepo) EXPECT() *MockCategoryRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryRepo) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryRepoMockRecorder) DeleteCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryRepo)(nil).DeleteCategory), ctx, id)
+}
+
+// InsertCategory mocks base method.
+func (m *MockCategoryRepo) InsertCategory(ctx context.Context, data models.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCategory", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertCategory indicates an expected call of InsertCategory.
+func (mr *MockCategoryRepoMockRecorder) InsertCategory(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCategory", reflect.TypeOf((*MockCategoryRepo)(nil).InsertCategory), ctx, data)
+}
+
+// SelectCategories mocks base method.
+func (m *MockCategoryRepo) SelectCategories(ctx context.Context) ([]models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCategories", ctx)
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCategories indicates an expected call of SelectCategories.
+func (mr *MockCategoryRepoMockRecorder) SelectCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCategories", reflect.TypeOf((*MockCategoryRepo)(nil).SelectCategories), ctx)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryRepo) UpdateCategory(ctx context.Context, data models.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryRepoMockRecorder) UpdateCategory(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryRepo)(nil).UpdateCategory), ctx, data)
+}
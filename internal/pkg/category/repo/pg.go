@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/category"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/satori/uuid"
+)
+
+type CategoryRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateCategoryRepo(db pgxtype.Querier) *CategoryRepo {
+	return &CategoryRepo{db: db}
+}
+
+//go:embed sql/selectCategories.sql
+var selectCategories string
+
+//go:embed sql/insertCategory.sql
+var insertCategory string
+
+//go:embed sql/updateCategory.sql
+var updateCategory string
+
+//go:embed sql/deleteCategory.sql
+var deleteCategory string
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+func parentArg(parentId uuid.UUID) interface{} {
+	if parentId == uuid.Nil {
+		return nil
+	}
+	return parentId
+}
+
+// attributesArg keeps the NOT NULL column an array when there is no schema.
+func attributesArg(attrs models.AttributeSchemaList) models.AttributeSchemaList {
+	if attrs == nil {
+		return models.AttributeSchemaList{}
+	}
+	return attrs
+}
+
+func (r *CategoryRepo) SelectCategories(ctx context.Context) ([]models.Category, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectCategories)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := make([]models.Category, 0)
+	for rows.Next() {
+		var (
+			c        models.Category
+			parentId uuid.NullUUID
+		)
+		if err := rows.Scan(&c.Id, &parentId, &c.Name, &c.Attributes); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		c.ParentId = parentId.UUID
+		categories = append(categories, c)
+	}
+	return categories, nil
+}
+
+func (r *CategoryRepo) InsertCategory(ctx context.Context, data models.Category) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, insertCategory, data.Id, parentArg(data.ParentId), data.Name, attributesArg(data.Attributes))
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return category.ErrCategoryExists
+		case foreignKeyViolation:
+			return category.ErrParentNotFound
+		}
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return category.ErrCreatingCategory
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (r *CategoryRepo) UpdateCategory(ctx context.Context, data models.Category) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, updateCategory, data.Id, parentArg(data.ParentId), data.Name, attributesArg(data.Attributes))
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return category.ErrCategoryExists
+		case foreignKeyViolation:
+			return category.ErrParentNotFound
+		}
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return category.ErrUpdatingCategory
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(category.ErrCategoryNotFound.Error())
+		return category.ErrCategoryNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (r *CategoryRepo) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, deleteCategory, id)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		loggerVar.Error(category.ErrCategoryInUse.Error())
+		return category.ErrCategoryInUse
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return category.ErrDeletingCategory
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(category.ErrCategoryNotFound.Error())
+		return category.ErrCategoryNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
@@ -0,0 +1 @@
+DELETE FROM categories WHERE id = $1
\ No newline at end of file
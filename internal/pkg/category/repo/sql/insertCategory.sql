@@ -0,0 +1 @@
+INSERT INTO categories (id, parent_id, name, attributes) VALUES ($1, $2, $3, $4)
\ No newline at end of file
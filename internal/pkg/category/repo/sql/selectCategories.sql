@@ -0,0 +1 @@
+SELECT id, parent_id, name, attributes FROM categories ORDER BY name
\ No newline at end of file
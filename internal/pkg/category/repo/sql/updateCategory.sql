@@ -0,0 +1 @@
+UPDATE categories SET parent_id = $2, name = $3, attributes = $4 WHERE id = $1
\ No newline at end of file
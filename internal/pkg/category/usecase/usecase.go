@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/category"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+type CategoryUsecase struct {
+	repo category.CategoryRepo
+}
+
+func CreateCategoryUsecase(repo category.CategoryRepo) *CategoryUsecase {
+	return &CategoryUsecase{repo: repo}
+}
+
+// GetCategoryTree returns root categories with their subcategories nested.
+// Siblings keep the order of SelectCategories, which is by name.
+func (uc *CategoryUsecase) GetCategoryTree(ctx context.Context) (models.CategoryRespList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	categories, err := uc.repo.SelectCategories(ctx)
+	if err != nil {
+		loggerVar.Error("error fetching categories: " + err.Error())
+		return nil, err
+	}
+
+	children := make(map[uuid.UUID][]models.Category, len(categories))
+	for _, c := range categories {
+		children[c.ParentId] = append(children[c.ParentId], c)
+	}
+
+	var build func(parentId uuid.UUID) models.CategoryRespList
+	build = func(parentId uuid.UUID) models.CategoryRespList {
+		nodes := make(models.CategoryRespList, 0, len(children[parentId]))
+		for _, c := range children[parentId] {
+			nodes = append(nodes, models.CategoryResp{
+				Id:         c.Id,
+				Name:       c.Name,
+				Attributes: c.Attributes,
+				Children:   build(c.Id),
+			})
+		}
+		return nodes
+	}
+
+	return build(uuid.Nil), nil
+}
+
+// GetCategory returns a category with the full attribute schema its ads are
+// validated against, including the attributes inherited from ancestors.
+func (uc *CategoryUsecase) GetCategory(ctx context.Context, id uuid.UUID) (models.Category, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	categories, err := uc.repo.SelectCategories(ctx)
+	if err != nil {
+		loggerVar.Error("error fetching categories: " + err.Error())
+		return models.Category{}, err
+	}
+
+	byId := make(map[uuid.UUID]models.Category, len(categories))
+	for _, c := range categories {
+		byId[c.Id] = c
+	}
+
+	result, ok := byId[id]
+	if !ok {
+		loggerVar.Error(category.ErrCategoryNotFound.Error())
+		return models.Category{}, category.ErrCategoryNotFound
+	}
+
+	chain := []models.Category{result}
+	for parent, ok := byId[result.ParentId]; ok && len(chain) <= len(categories); parent, ok = byId[parent.ParentId] {
+		chain = append(chain, parent)
+	}
+
+	var schema models.AttributeSchemaList
+	for i := len(chain) - 1; i >= 0; i-- {
+		schema = schema.Extend(chain[i].Attributes)
+	}
+	result.Attributes = schema
+
+	return result, nil
+}
+
+func (uc *CategoryUsecase) CreateCategory(ctx context.Context, data models.Category) (models.Category, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	data.Id = uuid.NewV4()
+	if err := uc.repo.InsertCategory(ctx, data); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Category{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return data, nil
+}
+
+// UpdateCategory renames a category and moves it under another parent. The
+// new parent must not be the category itself or one of its descendants,
+// otherwise the subtree would be cut off from the root.
+func (uc *CategoryUsecase) UpdateCategory(ctx context.Context, data models.Category) (models.Category, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	categories, err := uc.repo.SelectCategories(ctx)
+	if err != nil {
+		loggerVar.Error("error fetching categories: " + err.Error())
+		return models.Category{}, err
+	}
+
+	parents := make(map[uuid.UUID]uuid.UUID, len(categories))
+	for _, c := range categories {
+		parents[c.Id] = c.ParentId
+	}
+
+	if _, ok := parents[data.Id]; !ok {
+		loggerVar.Error(category.ErrCategoryNotFound.Error())
+		return models.Category{}, category.ErrCategoryNotFound
+	}
+	for id := data.ParentId; id != uuid.Nil; id = parents[id] {
+		if id == data.Id {
+			loggerVar.Error(category.ErrCategoryCycle.Error())
+			return models.Category{}, category.ErrCategoryCycle
+		}
+		if _, ok := parents[id]; !ok {
+			loggerVar.Error(category.ErrParentNotFound.Error())
+			return models.Category{}, category.ErrParentNotFound
+		}
+	}
+
+	if err := uc.repo.UpdateCategory(ctx, data); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Category{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return data, nil
+}
+
+func (uc *CategoryUsecase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.repo.DeleteCategory(ctx, id); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/category"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/category/mocks"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetCategoryTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transport := models.Category{Id: uuid.NewV4(), Name: "Транспорт"}
+	cars := models.Category{Id: uuid.NewV4(), ParentId: transport.Id, Name: "Автомобили"}
+	moto := models.Category{Id: uuid.NewV4(), ParentId: transport.Id, Name: "Мотоциклы"}
+	realty := models.Category{Id: uuid.NewV4(), Name: "Недвижимость"}
+
+	mockRepo := mocks.NewMockCategoryRepo(ctrl)
+	mockRepo.EXPECT().SelectCategories(gomock.Any()).Return([]models.Category{cars, moto, realty, transport}, nil)
+
+	uc := CreateCategoryUsecase(mockRepo)
+	tree, err := uc.GetCategoryTree(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.CategoryRespList{
+		{Id: realty.Id, Name: realty.Name, Children: models.CategoryRespList{}},
+		{Id: transport.Id, Name: transport.Name, Children: models.CategoryRespList{
+			{Id: cars.Id, Name: cars.Name, Children: models.CategoryRespList{}},
+			{Id: moto.Id, Name: moto.Name, Children: models.CategoryRespList{}},
+		}},
+	}, tree)
+}
+
+func TestUpdateCategory(t *testing.T) {
+	root := models.Category{Id: uuid.NewV4(), Name: "root"}
+	child := models.Category{Id: uuid.NewV4(), ParentId: root.Id, Name: "child"}
+	grandchild := models.Category{Id: uuid.NewV4(), ParentId: child.Id, Name: "grandchild"}
+	other := models.Category{Id: uuid.NewV4(), Name: "other"}
+	stored := []models.Category{root, child, grandchild, other}
+
+	tests := []struct {
+		name        string
+		data        models.Category
+		updated     bool
+		expectedErr error
+	}{
+		{
+			name:    "Move to another root",
+			data:    models.Category{Id: child.Id, ParentId: other.Id, Name: "child"},
+			updated: true,
+		},
+		{
+			name:    "Move to root",
+			data:    models.Category{Id: child.Id, Name: "child"},
+			updated: true,
+		},
+		{
+			name:        "Under itself",
+			data:        models.Category{Id: child.Id, ParentId: child.Id, Name: "child"},
+			expectedErr: category.ErrCategoryCycle,
+		},
+		{
+			name:        "Under descendant",
+			data:        models.Category{Id: root.Id, ParentId: grandchild.Id, Name: "root"},
+			expectedErr: category.ErrCategoryCycle,
+		},
+		{
+			name:        "Unknown parent",
+			data:        models.Category{Id: child.Id, ParentId: uuid.NewV4(), Name: "child"},
+			expectedErr: category.ErrParentNotFound,
+		},
+		{
+			name:        "Unknown category",
+			data:        models.Category{Id: uuid.NewV4(), Name: "child"},
+			expectedErr: category.ErrCategoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockCategoryRepo(ctrl)
+			mockRepo.EXPECT().SelectCategories(gomock.Any()).Return(stored, nil)
+			if tt.updated {
+				mockRepo.EXPECT().UpdateCategory(gomock.Any(), tt.data).Return(nil)
+			}
+
+			uc := CreateCategoryUsecase(mockRepo)
+			_, err := uc.UpdateCategory(context.Background(), tt.data)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
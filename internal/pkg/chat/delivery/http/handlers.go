@@ -0,0 +1,276 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/chat"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+type ChatHandler struct {
+	uc chat.ChatUsecase
+}
+
+func CreateChatHandler(uc chat.ChatUsecase) *ChatHandler {
+	return &ChatHandler{uc: uc}
+}
+
+// StartConversation sends a message to the seller of an ad, starting the
+// conversation on the first one.
+func (h *ChatHandler) StartConversation(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	adId, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing ad id: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid ad id", http.StatusBadRequest)
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	text, ok := parseMessage(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	message, err := h.uc.StartConversation(r.Context(), userId, adId, text)
+	if err != nil {
+		sendChatError(w, loggerVar, err)
+		return
+	}
+
+	w.Header().Set("Location", "/api/me/conversations/"+message.ConversationId.String()+"/messages")
+	sendJSON(w, loggerVar, toMessageResp(message, userId), http.StatusCreated)
+}
+
+// SendMessage replies in a conversation the user takes part in.
+func (h *ChatHandler) SendMessage(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseConversationId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	text, ok := parseMessage(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	message, err := h.uc.SendMessage(r.Context(), userId, id, text)
+	if err != nil {
+		sendChatError(w, loggerVar, err)
+		return
+	}
+
+	sendJSON(w, loggerVar, toMessageResp(message, userId), http.StatusCreated)
+}
+
+// GetConversations lists conversations of the user as a buyer and as a
+// seller, the latest activity first. It takes the page and limit
+// parameters of GetAds.
+func (h *ChatHandler) GetConversations(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+	limit := parseLimit(r.URL.Query())
+
+	conversations, err := h.uc.GetConversations(r.Context(), userId, page, limit)
+	if err != nil {
+		sendChatError(w, loggerVar, err)
+		return
+	}
+
+	resp := make(models.ConversationRespList, 0, len(conversations))
+	for _, c := range conversations {
+		item := models.ConversationResp{
+			Id:          c.Id,
+			AdId:        c.AdId,
+			AdTitle:     c.AdTitle,
+			Role:        "buyer",
+			PeerLogin:   c.PeerLogin,
+			ReadOnly:    c.ReadOnly,
+			UnreadCount: c.Unread,
+			CreatedAt:   c.CreatedAt,
+		}
+		if c.SellerId == userId {
+			item.Role = "seller"
+		}
+		if c.LastMessage != nil {
+			last := toMessageResp(*c.LastMessage, userId)
+			item.LastMessage = &last
+		}
+		resp = append(resp, item)
+	}
+
+	sendJSON(w, loggerVar, resp, http.StatusOK)
+}
+
+// GetMessages returns the history of a conversation, the newest messages
+// first. The next page is asked for with before set to the id of the last
+// message of the current one, it is also sent in X-Next-Cursor.
+func (h *ChatHandler) GetMessages(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, ok := parseConversationId(w, r, loggerVar)
+	if !ok {
+		return
+	}
+	userId, ok := getUserIdFromContext(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	q := r.URL.Query()
+	before := uuid.Nil
+	if param := q.Get("before"); param != "" {
+		var err error
+		if before, err = uuid.FromString(param); err != nil {
+			logger.LogHandlerError(loggerVar, chat.ErrInvalidCursor, http.StatusBadRequest)
+			sendErr.SendError(w, chat.ErrInvalidCursor.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	limit := parseLimit(q)
+
+	list, err := h.uc.GetMessages(r.Context(), userId, id, before, limit)
+	if err != nil {
+		sendChatError(w, loggerVar, err)
+		return
+	}
+
+	resp := make(models.MessageRespList, 0, len(list.Messages))
+	for _, message := range list.Messages {
+		resp = append(resp, toMessageResp(message, userId))
+	}
+
+	if list.HasNext && len(list.Messages) > 0 {
+		next := list.Messages[len(list.Messages)-1].Id.String()
+		q.Set("before", next)
+		link := url.URL{Path: r.URL.Path, RawQuery: q.Encode()}
+		w.Header().Set("X-Next-Cursor", next)
+		w.Header().Add("Link", "<"+link.String()+`>; rel="next"`)
+	}
+	sendJSON(w, loggerVar, resp, http.StatusOK)
+}
+
+func parseLimit(q url.Values) int {
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return limit
+}
+
+func parseConversationId(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (uuid.UUID, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing conversation id: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid conversation id", http.StatusBadRequest)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// parseMessage reads the text of a new message, surrounding whitespace is
+// dropped.
+func parseMessage(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (string, bool) {
+	var req models.MessageReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return "", false
+	}
+
+	text := strings.TrimSpace(req.Text)
+	if !validation.ValidMessage(text) {
+		logger.LogHandlerError(loggerVar, chat.ErrInvalidMessage, http.StatusBadRequest)
+		sendErr.SendError(w, chat.ErrInvalidMessage.Error(), http.StatusBadRequest)
+		return "", false
+	}
+	return text, true
+}
+
+func getUserIdFromContext(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (uuid.UUID, bool) {
+	userId, ok := jwtUtils.GetIdFromContext(r.Context())
+	if !ok {
+		logger.LogHandlerError(loggerVar, errors.New("error while getting user id from context"), http.StatusInternalServerError)
+		sendErr.SendError(w, "server error", http.StatusInternalServerError)
+		return uuid.Nil, false
+	}
+	return userId, true
+}
+
+func toMessageResp(message models.Message, userId uuid.UUID) models.MessageResp {
+	return models.MessageResp{
+		Id:             message.Id,
+		ConversationId: message.ConversationId,
+		IsMine:         message.SenderId == userId,
+		Text:           message.Text,
+		CreatedAt:      message.CreatedAt,
+		ReadAt:         message.ReadAt,
+	}
+}
+
+func sendJSON(w http.ResponseWriter, loggerVar *slog.Logger, v interface{}, statusCode int) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+// sendChatError maps errors returned by the chat usecase to HTTP statuses.
+func sendChatError(w http.ResponseWriter, loggerVar *slog.Logger, err error) {
+	var statusCode int
+	switch {
+	case errors.Is(err, chat.ErrSelfMessage), errors.Is(err, chat.ErrInvalidCursor):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, chat.ErrAdNotFound), errors.Is(err, chat.ErrConversationNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, chat.ErrReadOnly):
+		statusCode = http.StatusConflict
+	case errors.Is(err, chat.ErrSendingMessage):
+		statusCode = http.StatusInternalServerError
+	default:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "unknown error", http.StatusInternalServerError)
+		return
+	}
+
+	logger.LogHandlerError(loggerVar, err, statusCode)
+	sendErr.SendError(w, err.Error(), statusCode)
+}
@@ -0,0 +1,188 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/chat"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/chat/mocks"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStartConversation(t *testing.T) {
+	userId := uuid.NewV4()
+	adId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		body           string
+		usecaseMocker  func(*mocks.MockChatUsecase)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"text": "  Ещё продаёте?\n"}`,
+			usecaseMocker: func(uc *mocks.MockChatUsecase) {
+				uc.EXPECT().StartConversation(gomock.Any(), userId, adId, "Ещё продаёте?").
+					Return(models.Message{Id: uuid.NewV4(), ConversationId: uuid.NewV4(), SenderId: userId, Text: "Ещё продаёте?"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Empty message",
+			body:           `{"text": "   "}`,
+			usecaseMocker:  func(*mocks.MockChatUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Too long message",
+			body:           `{"text": "` + strings.Repeat("a", 1001) + `"}`,
+			usecaseMocker:  func(*mocks.MockChatUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Own ad",
+			body: `{"text": "Привет"}`,
+			usecaseMocker: func(uc *mocks.MockChatUsecase) {
+				uc.EXPECT().StartConversation(gomock.Any(), userId, adId, "Привет").Return(models.Message{}, chat.ErrSelfMessage)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Archived ad",
+			body: `{"text": "Привет"}`,
+			usecaseMocker: func(uc *mocks.MockChatUsecase) {
+				uc.EXPECT().StartConversation(gomock.Any(), userId, adId, "Привет").Return(models.Message{}, chat.ErrReadOnly)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockChatUsecase(ctrl)
+			tt.usecaseMocker(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/ad/"+adId.String()+"/messages", bytes.NewBufferString(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"id": adId.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			rr := httptest.NewRecorder()
+			handler := CreateChatHandler(mockUsecase)
+
+			handler.StartConversation(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusCreated {
+				assert.Contains(t, rr.Body.String(), `"is_mine":true`)
+			}
+		})
+	}
+}
+
+func TestGetConversations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userId := uuid.NewV4()
+	mockUsecase := mocks.NewMockChatUsecase(ctrl)
+	mockUsecase.EXPECT().GetConversations(gomock.Any(), userId, 1, 20).Return([]models.Conversation{{
+		Id:          uuid.NewV4(),
+		BuyerId:     uuid.NewV4(),
+		SellerId:    userId,
+		PeerLogin:   "buyer",
+		Unread:      2,
+		LastMessage: &models.Message{Id: uuid.NewV4(), Text: "Ещё продаёте?"},
+	}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/me/conversations", nil)
+	req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+	rr := httptest.NewRecorder()
+	handler := CreateChatHandler(mockUsecase)
+
+	handler.GetConversations(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"role":"seller"`)
+	assert.Contains(t, rr.Body.String(), `"unread_count":2`)
+	assert.Contains(t, rr.Body.String(), `"is_mine":false`)
+}
+
+func TestGetMessages(t *testing.T) {
+	userId := uuid.NewV4()
+	id := uuid.NewV4()
+	oldest := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		query          string
+		usecaseMocker  func(*mocks.MockChatUsecase)
+		expectedStatus int
+		expectedNext   string
+	}{
+		{
+			name:  "First page",
+			query: "?limit=2",
+			usecaseMocker: func(uc *mocks.MockChatUsecase) {
+				uc.EXPECT().GetMessages(gomock.Any(), userId, id, uuid.Nil, 2).
+					Return(models.MessageList{Messages: []models.Message{{Id: uuid.NewV4()}, {Id: oldest}}, HasNext: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedNext:   oldest.String(),
+		},
+		{
+			name:  "Not a participant",
+			query: "",
+			usecaseMocker: func(uc *mocks.MockChatUsecase) {
+				uc.EXPECT().GetMessages(gomock.Any(), userId, id, uuid.Nil, 20).Return(models.MessageList{}, chat.ErrConversationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "Cursor of another conversation",
+			query: "?before=" + oldest.String(),
+			usecaseMocker: func(uc *mocks.MockChatUsecase) {
+				uc.EXPECT().GetMessages(gomock.Any(), userId, id, oldest, 20).Return(models.MessageList{}, chat.ErrInvalidCursor)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid cursor",
+			query:          "?before=latest",
+			usecaseMocker:  func(*mocks.MockChatUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockChatUsecase(ctrl)
+			tt.usecaseMocker(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/conversations/"+id.String()+"/messages"+tt.query, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			rr := httptest.NewRecorder()
+			handler := CreateChatHandler(mockUsecase)
+
+			handler.GetMessages(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedNext, rr.Header().Get("X-Next-Cursor"))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrAdNotFound           = errors.New("ad not found")
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrSelfMessage          = errors.New("sellers can not message themselves")
+	ErrReadOnly             = errors.New("conversation is read-only: the ad is archived")
+	ErrInvalidMessage       = errors.New("invalid message: from 1 to 1000 characters expected")
+	ErrInvalidCursor        = errors.New("invalid cursor")
+	ErrSendingMessage       = errors.New("message sending error")
+)
+
+type ChatUsecase interface {
+	StartConversation(ctx context.Context, buyerId, adId uuid.UUID, text string) (models.Message, error)
+	SendMessage(ctx context.Context, userId, id uuid.UUID, text string) (models.Message, error)
+	GetConversations(ctx context.Context, userId uuid.UUID, page, limit int) ([]models.Conversation, error)
+	GetMessages(ctx context.Context, userId, id, before uuid.UUID, limit int) (models.MessageList, error)
+}
+
+type ChatRepo interface {
+	SelectAdSeller(ctx context.Context, adId uuid.UUID) (uuid.UUID, models.AdStatus, error)
+	StartConversation(ctx context.Context, adId, buyerId, sellerId uuid.UUID, text string) (models.Message, error)
+	SelectConversation(ctx context.Context, id uuid.UUID) (models.Conversation, error)
+	SelectConversations(ctx context.Context, userId uuid.UUID, limit, offset int) ([]models.Conversation, error)
+	InsertMessage(ctx context.Context, id, senderId uuid.UUID, text string) (models.Message, error)
+	SelectMessages(ctx context.Context, id, before uuid.UUID, limit int) ([]models.Message, error)
+	MarkRead(ctx context.Context, id, readerId uuid.UUID) error
+}
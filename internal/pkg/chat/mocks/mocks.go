@@ -0,0 +1,232 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/chat/interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/pkg/chat/interfaces.go -destination=internal/pkg/chat/mocks/mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/go_vk_intern/internal/models"
+	uuid "github.com/satori/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChatUsecase is a mock of ChatUsecase interface.
+type MockChatUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChatUsecaseMockRecorder is the mock recorder for MockChatUsecase.
+type MockChatUsecaseMockRecorder struct {
+	mock *MockChatUsecase
+}
+
+// NewMockChatUsecase creates a new mock instance.
+func NewMockChatUsecase(ctrl *gomock.Controller) *MockChatUsecase {
+	mock := &MockChatUsecase{ctrl: ctrl}
+	mock.recorder = &MockChatUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatUsecase) EXPECT() *MockChatUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetConversations mocks base method.
+func (m *MockChatUsecase) GetConversations(ctx context.Context, userId uuid.UUID, page, limit int) ([]models.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversations", ctx, userId, page, limit)
+	ret0, _ := ret[0].([]models.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversations indicates an expected call of GetConversations.
+func (mr *MockChatUsecaseMockRecorder) GetConversations(ctx, userId, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversations", reflect.TypeOf((*MockChatUsecase)(nil).GetConversations), ctx, userId, page, limit)
+}
+
+// GetMessages mocks base method.
+func (m *MockChatUsecase) GetMessages(ctx context.Context, userId, id, before uuid.UUID, limit int) (models.MessageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessages", ctx, userId, id, before, limit)
+	ret0, _ := ret[0].(models.MessageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessages indicates an expected call of GetMessages.
+func (mr *MockChatUsecaseMockRecorder) GetMessages(ctx, userId, id, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockChatUsecase)(nil).GetMessages), ctx, userId, id, before, limit)
+}
+
+// SendMessage mocks base method.
+func (m *MockChatUsecase) SendMessage(ctx context.Context, userId, id uuid.UUID, text string) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMessage", ctx, userId, id, text)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMessage indicates an expected call of SendMessage.
+func (mr *MockChatUsecaseMockRecorder) SendMessage(ctx, userId, id, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockChatUsecase)(nil).SendMessage), ctx, userId, id, text)
+}
+
+// StartConversation mocks base method.
+func (m *MockChatUsecase) StartConversation(ctx context.Context, buyerId, adId uuid.UUID, text string) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConversation", ctx, buyerId, adId, text)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConversation indicates an expected call of StartConversation.
+func (mr *MockChatUsecaseMockRecorder) StartConversation(ctx, buyerId, adId, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConversation", reflect.TypeOf((*MockChatUsecase)(nil).StartConversation), ctx, buyerId, adId, text)
+}
+
+// MockChatRepo is a mock of ChatRepo interface.
+type MockChatRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockChatRepoMockRecorder is the mock recorder for MockChatRepo.
+type MockChatRepoMockRecorder struct {
+	mock *MockChatRepo
+}
+
+// NewMockChatRepo creates a new mock instance.
+func NewMockChatRepo(ctrl *gomock.Controller) *MockChatRepo {
+	mock := &MockChatRepo{ctrl: ctrl}
+	mock.recorder = &MockChatRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatRepo) EXPECT() *MockChatRepoMockRecorder {
+	return m.recorder
+}
+
+// InsertMessage mocks base method.
+func (m *MockChatRepo) InsertMessage(ctx context.Context, id, senderId uuid.UUID, text string) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMessage", ctx, id, senderId, text)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertMessage indicates an expected call of InsertMessage.
+func (mr *MockChatRepoMockRecorder) InsertMessage(ctx, id, senderId, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMessage", reflect.TypeOf((*MockChatRepo)(nil).InsertMessage), ctx, id, senderId, text)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatRepo) MarkRead(ctx context.Context, id, readerId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, id, readerId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatRepoMockRecorder) MarkRead(ctx, id, readerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatRepo)(nil).MarkRead), ctx, id, readerId)
+}
+
+// SelectAdSeller mocks base method.
+func (m *MockChatRepo) SelectAdSeller(ctx context.Context, adId uuid.UUID) (uuid.UUID, models.AdStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAdSeller", ctx, adId)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(models.AdStatus)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectAdSeller indicates an expected call of SelectAdSeller.
+func (mr *MockChatRepoMockRecorder) SelectAdSeller(ctx, adId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAdSeller", reflect.TypeOf((*MockChatRepo)(nil).SelectAdSeller), ctx, adId)
+}
+
+// SelectConversation mocks base method.
+func (m *MockChatRepo) SelectConversation(ctx context.Context, id uuid.UUID) (models.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConversation", ctx, id)
+	ret0, _ := ret[0].(models.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConversation indicates an expected call of SelectConversation.
+func (mr *MockChatRepoMockRecorder) SelectConversation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConversation", reflect.TypeOf((*MockChatRepo)(nil).SelectConversation), ctx, id)
+}
+
+// SelectConversations mocks base method.
+func (m *MockChatRepo) SelectConversations(ctx context.Context, userId uuid.UUID, limit, offset int) ([]models.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConversations", ctx, userId, limit, offset)
+	ret0, _ := ret[0].([]models.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConversations indicates an expected call of SelectConversations.
+func (mr *MockChatRepoMockRecorder) SelectConversations(ctx, userId, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConversations", reflect.TypeOf((*MockChatRepo)(nil).SelectConversations), ctx, userId, limit, offset)
+}
+
+// SelectMessages mocks base method.
+func (m *MockChatRepo) SelectMessages(ctx context.Context, id, before uuid.UUID, limit int) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMessages", ctx, id, before, limit)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMessages indicates an expected call of SelectMessages.
+func (mr *MockChatRepoMockRecorder) SelectMessages(ctx, id, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMessages", reflect.TypeOf((*MockChatRepo)(nil).SelectMessages), ctx, id, before, limit)
+}
+
+// StartConversation mocks base method.
+func (m *MockChatRepo) StartConversation(ctx context.Context, adId, buyerId, sellerId uuid.UUID, text string) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConversation", ctx, adId, buyerId, sellerId, text)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConversation indicates an expected call of StartConversation.
+func (mr *MockChatRepoMockRecorder) StartConversation(ctx, adId, buyerId, sellerId, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConversation", reflect.TypeOf((*MockChatRepo)(nil).StartConversation), ctx, adId, buyerId, sellerId, text)
+}
@@ -0,0 +1,236 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/chat"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+type ChatRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateChatRepo(db pgxtype.Querier) *ChatRepo {
+	return &ChatRepo{db: db}
+}
+
+//go:embed sql/selectAdSeller.sql
+var selectAdSeller string
+
+//go:embed sql/startConversation.sql
+var startConversation string
+
+//go:embed sql/selectConversation.sql
+var selectConversation string
+
+//go:embed sql/selectConversations.sql
+var selectConversations string
+
+//go:embed sql/insertMessage.sql
+var insertMessage string
+
+//go:embed sql/selectMessages.sql
+var selectMessages string
+
+//go:embed sql/selectMessageExists.sql
+var selectMessageExists string
+
+//go:embed sql/markRead.sql
+var markRead string
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMessage(row scanner, message *models.Message) error {
+	return row.Scan(&message.Id, &message.ConversationId, &message.SenderId, &message.Text, &message.CreatedAt, &message.ReadAt)
+}
+
+// SelectAdSeller returns the owner and the status of an ad which is not
+// deleted.
+func (r *ChatRepo) SelectAdSeller(ctx context.Context, adId uuid.UUID) (uuid.UUID, models.AdStatus, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var sellerId uuid.UUID
+	var status models.AdStatus
+	err := r.db.QueryRow(ctx, selectAdSeller, adId).Scan(&sellerId, &status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(chat.ErrAdNotFound.Error())
+		return uuid.Nil, "", chat.ErrAdNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return uuid.Nil, "", err
+	}
+	return sellerId, status, nil
+}
+
+// StartConversation adds a message of a buyer to the conversation about an
+// ad, creating the conversation on the first message, in one statement. A
+// conversation created by a concurrent first message is not visible to the
+// statement which ran into it, so it is run once more before the ad is
+// reported as archived or deleted.
+func (r *ChatRepo) StartConversation(ctx context.Context, adId, buyerId, sellerId uuid.UUID, text string) (models.Message, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var message models.Message
+	err := scanMessage(r.db.QueryRow(ctx, startConversation, adId, buyerId, sellerId, text), &message)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = scanMessage(r.db.QueryRow(ctx, startConversation, adId, buyerId, sellerId, text), &message)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(chat.ErrReadOnly.Error())
+		return models.Message{}, chat.ErrReadOnly
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Message{}, chat.ErrSendingMessage
+	}
+
+	loggerVar.Info("Successful")
+	return message, nil
+}
+
+func (r *ChatRepo) SelectConversation(ctx context.Context, id uuid.UUID) (models.Conversation, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var c models.Conversation
+	err := r.db.QueryRow(ctx, selectConversation, id).Scan(&c.Id, &c.AdId, &c.AdTitle, &c.BuyerId, &c.SellerId, &c.ReadOnly, &c.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(chat.ErrConversationNotFound.Error())
+		return models.Conversation{}, chat.ErrConversationNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Conversation{}, err
+	}
+	return c, nil
+}
+
+// SelectConversations returns conversations of a user with the latest
+// activity first, each with its last message and the number of messages
+// the user has not read yet.
+func (r *ChatRepo) SelectConversations(ctx context.Context, userId uuid.UUID, limit, offset int) ([]models.Conversation, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectConversations, userId, limit, offset)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	conversations := make([]models.Conversation, 0, limit)
+	for rows.Next() {
+		var (
+			c                  models.Conversation
+			lastId, lastSender uuid.NullUUID
+			lastText           *string
+			lastCreatedAt      *time.Time
+			lastReadAt         *time.Time
+		)
+		err := rows.Scan(&c.Id, &c.AdId, &c.AdTitle, &c.BuyerId, &c.SellerId, &c.PeerLogin, &c.ReadOnly, &c.Unread,
+			&lastId, &lastSender, &lastText, &lastCreatedAt, &lastReadAt, &c.CreatedAt)
+		if err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		if lastId.Valid {
+			c.LastMessage = &models.Message{
+				Id:             lastId.UUID,
+				ConversationId: c.Id,
+				SenderId:       lastSender.UUID,
+				Text:           *lastText,
+				CreatedAt:      *lastCreatedAt,
+				ReadAt:         lastReadAt,
+			}
+		}
+		conversations = append(conversations, c)
+	}
+	return conversations, nil
+}
+
+// InsertMessage adds a message unless the ad of the conversation was
+// archived or deleted in the meantime.
+func (r *ChatRepo) InsertMessage(ctx context.Context, id, senderId uuid.UUID, text string) (models.Message, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var message models.Message
+	err := scanMessage(r.db.QueryRow(ctx, insertMessage, id, senderId, text), &message)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(chat.ErrReadOnly.Error())
+		return models.Message{}, chat.ErrReadOnly
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Message{}, chat.ErrSendingMessage
+	}
+
+	loggerVar.Info("Successful")
+	return message, nil
+}
+
+// SelectMessages returns up to limit messages of a conversation older than
+// the message before, the newest first. uuid.Nil starts from the newest. A
+// message of another conversation is reported as an invalid cursor.
+func (r *ChatRepo) SelectMessages(ctx context.Context, id, before uuid.UUID, limit int) ([]models.Message, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var beforeArg interface{}
+	if before != uuid.Nil {
+		beforeArg = before
+	}
+
+	rows, err := r.db.Query(ctx, selectMessages, id, beforeArg, limit)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]models.Message, 0, limit)
+	for rows.Next() {
+		var message models.Message
+		if err := scanMessage(rows, &message); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	rows.Close()
+
+	// before of another conversation matches no messages, the page is only
+	// empty for a foreign cursor or at the end of the history
+	if len(messages) == 0 && before != uuid.Nil {
+		var exists bool
+		if err := r.db.QueryRow(ctx, selectMessageExists, id, before).Scan(&exists); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		if !exists {
+			loggerVar.Error(chat.ErrInvalidCursor.Error())
+			return nil, chat.ErrInvalidCursor
+		}
+	}
+	return messages, nil
+}
+
+// MarkRead marks messages the other side sent to readerId as read.
+func (r *ChatRepo) MarkRead(ctx context.Context, id, readerId uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := r.db.Exec(ctx, markRead, id, readerId); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+INSERT INTO messages (conversation_id, sender_id, text)
+SELECT c.id, $2, $3
+FROM conversations AS c
+JOIN ads AS a ON c.ad_id = a.id
+WHERE c.id = $1 AND a.status <> 'archived' AND a.deleted_at IS NULL
+RETURNING id, conversation_id, sender_id, text, created_at, read_at
\ No newline at end of file
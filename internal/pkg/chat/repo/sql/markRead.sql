@@ -0,0 +1,3 @@
+UPDATE messages
+SET read_at = now()
+WHERE conversation_id = $1 AND sender_id <> $2 AND read_at IS NULL
\ No newline at end of file
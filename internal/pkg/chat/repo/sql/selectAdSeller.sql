@@ -0,0 +1,3 @@
+SELECT user_id, status
+FROM ads
+WHERE id = $1 AND deleted_at IS NULL
\ No newline at end of file
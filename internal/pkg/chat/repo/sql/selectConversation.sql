@@ -0,0 +1,4 @@
+SELECT c.id, c.ad_id, a.title, c.buyer_id, c.seller_id, a.status = 'archived' OR a.deleted_at IS NOT NULL, c.created_at
+FROM conversations AS c
+JOIN ads AS a ON c.ad_id = a.id
+WHERE c.id = $1
\ No newline at end of file
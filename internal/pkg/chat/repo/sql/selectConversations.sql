@@ -0,0 +1,16 @@
+SELECT c.id, c.ad_id, a.title, c.buyer_id, c.seller_id, peer.login, a.status = 'archived' OR a.deleted_at IS NOT NULL,
+    (SELECT count(*) FROM messages AS m WHERE m.conversation_id = c.id AND m.sender_id <> $1 AND m.read_at IS NULL),
+    last.id, last.sender_id, last.text, last.created_at, last.read_at, c.created_at
+FROM conversations AS c
+JOIN ads AS a ON c.ad_id = a.id
+JOIN users AS peer ON peer.id = CASE WHEN c.buyer_id = $1 THEN c.seller_id ELSE c.buyer_id END
+LEFT JOIN LATERAL (
+    SELECT m.id, m.sender_id, m.text, m.created_at, m.read_at
+    FROM messages AS m
+    WHERE m.conversation_id = c.id
+    ORDER BY m.created_at DESC, m.id DESC
+    LIMIT 1
+) AS last ON true
+WHERE c.buyer_id = $1 OR c.seller_id = $1
+ORDER BY coalesce(last.created_at, c.created_at) DESC, c.id
+LIMIT $2 OFFSET $3
\ No newline at end of file
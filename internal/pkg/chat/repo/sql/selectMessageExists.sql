@@ -0,0 +1 @@
+SELECT EXISTS (SELECT 1 FROM messages WHERE id = $2 AND conversation_id = $1)
\ No newline at end of file
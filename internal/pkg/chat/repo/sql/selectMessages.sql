@@ -0,0 +1,6 @@
+SELECT id, conversation_id, sender_id, text, created_at, read_at
+FROM messages
+WHERE conversation_id = $1
+    AND ($2::uuid IS NULL OR (created_at, id) < (SELECT created_at, id FROM messages WHERE id = $2 AND conversation_id = $1))
+ORDER BY created_at DESC, id DESC
+LIMIT $3
\ No newline at end of file
@@ -0,0 +1,17 @@
+WITH ad AS (
+    SELECT id FROM ads WHERE id = $1 AND status <> 'archived' AND deleted_at IS NULL
+), created AS (
+    INSERT INTO conversations (ad_id, buyer_id, seller_id)
+    SELECT ad.id, $2, $3 FROM ad
+    ON CONFLICT (ad_id, buyer_id, seller_id) DO NOTHING
+    RETURNING id
+), conversation AS (
+    SELECT id FROM created
+    UNION ALL
+    SELECT c.id FROM conversations AS c
+    JOIN ad ON c.ad_id = ad.id
+    WHERE c.buyer_id = $2 AND c.seller_id = $3
+)
+INSERT INTO messages (conversation_id, sender_id, text)
+SELECT id, $2, $4 FROM conversation
+RETURNING id, conversation_id, sender_id, text, created_at, read_at
\ No newline at end of file
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/ad"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/chat"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/events"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+type ChatUsecase struct {
+	repo   chat.ChatRepo
+	events events.Publisher
+}
+
+func CreateChatUsecase(repo chat.ChatRepo, publisher events.Publisher) *ChatUsecase {
+	return &ChatUsecase{repo: repo, events: publisher}
+}
+
+// StartConversation sends a message from a buyer to the seller of an ad.
+// The first message creates the conversation, later ones go to the same
+// one. Ads hidden from the buyer are reported as not found.
+func (uc *ChatUsecase) StartConversation(ctx context.Context, buyerId, adId uuid.UUID, text string) (models.Message, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	sellerId, status, err := uc.repo.SelectAdSeller(ctx, adId)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	switch {
+	case sellerId == buyerId:
+		loggerVar.Error(chat.ErrSelfMessage.Error())
+		return models.Message{}, chat.ErrSelfMessage
+	case ad.Hidden(status):
+		loggerVar.Error(chat.ErrAdNotFound.Error())
+		return models.Message{}, chat.ErrAdNotFound
+	case status == models.AdStatusArchived:
+		loggerVar.Error(chat.ErrReadOnly.Error())
+		return models.Message{}, chat.ErrReadOnly
+	}
+
+	message, err := uc.repo.StartConversation(ctx, adId, buyerId, sellerId, text)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	uc.notify(ctx, sellerId, message)
+	return message, nil
+}
+
+// SendMessage adds a message to a conversation userId takes part in.
+func (uc *ChatUsecase) SendMessage(ctx context.Context, userId, id uuid.UUID, text string) (models.Message, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	conversation, err := uc.participant(ctx, userId, id)
+	if err != nil {
+		return models.Message{}, err
+	}
+	if conversation.ReadOnly {
+		loggerVar.Error(chat.ErrReadOnly.Error())
+		return models.Message{}, chat.ErrReadOnly
+	}
+
+	message, err := uc.repo.InsertMessage(ctx, id, userId, text)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	recipientId := conversation.SellerId
+	if userId == conversation.SellerId {
+		recipientId = conversation.BuyerId
+	}
+	uc.notify(ctx, recipientId, message)
+	return message, nil
+}
+
+func (uc *ChatUsecase) GetConversations(ctx context.Context, userId uuid.UUID, page, limit int) ([]models.Conversation, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	conversations, err := uc.repo.SelectConversations(ctx, userId, limit, (page-1)*limit)
+	if err != nil {
+		loggerVar.Error("error fetching conversations: " + err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return conversations, nil
+}
+
+// GetMessages returns a page of the history of a conversation, the newest
+// messages first, and marks messages sent to userId as read. Pages are
+// chained by the id of the oldest message of the previous one.
+func (uc *ChatUsecase) GetMessages(ctx context.Context, userId, id, before uuid.UUID, limit int) (models.MessageList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := uc.participant(ctx, userId, id); err != nil {
+		return models.MessageList{}, err
+	}
+
+	if err := uc.repo.MarkRead(ctx, id, userId); err != nil {
+		loggerVar.Error("error marking messages read: " + err.Error())
+		return models.MessageList{}, err
+	}
+
+	// One extra row tells whether there are older messages.
+	messages, err := uc.repo.SelectMessages(ctx, id, before, limit+1)
+	if err != nil {
+		loggerVar.Error("error fetching messages: " + err.Error())
+		return models.MessageList{}, err
+	}
+
+	list := models.MessageList{Messages: messages}
+	if len(messages) > limit {
+		list.Messages, list.HasNext = messages[:limit], true
+	}
+
+	loggerVar.Info("Successful")
+	return list, nil
+}
+
+// notify pushes a new message to the open streams of its recipient.
+func (uc *ChatUsecase) notify(ctx context.Context, recipientId uuid.UUID, message models.Message) {
+	uc.events.Publish(ctx, recipientId, models.Event{
+		Type: models.EventMessage,
+		Data: models.MessageResp{
+			Id:             message.Id,
+			ConversationId: message.ConversationId,
+			Text:           message.Text,
+			CreatedAt:      message.CreatedAt,
+		},
+	})
+}
+
+// participant returns a conversation if userId is its buyer or seller.
+// Conversations of other users are reported as not found.
+func (uc *ChatUsecase) participant(ctx context.Context, userId, id uuid.UUID) (models.Conversation, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	conversation, err := uc.repo.SelectConversation(ctx, id)
+	if err != nil {
+		return models.Conversation{}, err
+	}
+	if conversation.BuyerId != userId && conversation.SellerId != userId {
+		loggerVar.Error(chat.ErrConversationNotFound.Error())
+		return models.Conversation{}, chat.ErrConversationNotFound
+	}
+	return conversation, nil
+}
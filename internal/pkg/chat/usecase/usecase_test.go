@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/chat"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/chat/mocks"
+	eventsMocks "github.com/K1tten2005/go_vk_intern/internal/pkg/events/mocks"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStartConversation(t *testing.T) {
+	buyerId := uuid.NewV4()
+	sellerId := uuid.NewV4()
+	adId := uuid.NewV4()
+	conversationId := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		userId      uuid.UUID
+		status      models.AdStatus
+		repoMocker  func(*mocks.MockChatRepo)
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			userId: buyerId,
+			status: models.AdStatusPublished,
+			repoMocker: func(repo *mocks.MockChatRepo) {
+				repo.EXPECT().StartConversation(gomock.Any(), adId, buyerId, sellerId, "Ещё продаёте?").
+					Return(models.Message{Id: uuid.NewV4(), ConversationId: conversationId, SenderId: buyerId}, nil)
+			},
+		},
+		{
+			name:        "Seller",
+			userId:      sellerId,
+			status:      models.AdStatusPublished,
+			repoMocker:  func(*mocks.MockChatRepo) {},
+			expectedErr: chat.ErrSelfMessage,
+		},
+		{
+			name:        "Draft",
+			userId:      buyerId,
+			status:      models.AdStatusDraft,
+			repoMocker:  func(*mocks.MockChatRepo) {},
+			expectedErr: chat.ErrAdNotFound,
+		},
+		{
+			name:        "Archived",
+			userId:      buyerId,
+			status:      models.AdStatusArchived,
+			repoMocker:  func(*mocks.MockChatRepo) {},
+			expectedErr: chat.ErrReadOnly,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockChatRepo(ctrl)
+			mockRepo.EXPECT().SelectAdSeller(gomock.Any(), adId).Return(sellerId, tt.status, nil)
+			tt.repoMocker(mockRepo)
+			mockEvents := eventsMocks.NewMockPublisher(ctrl)
+			if tt.expectedErr == nil {
+				mockEvents.EXPECT().Publish(gomock.Any(), sellerId, gomock.Any()).Do(func(_ context.Context, _ uuid.UUID, event models.Event) {
+					assert.Equal(t, models.EventMessage, event.Type)
+					assert.False(t, event.Data.(models.MessageResp).IsMine)
+				})
+			}
+
+			uc := CreateChatUsecase(mockRepo, mockEvents)
+			message, err := uc.StartConversation(context.Background(), tt.userId, adId, "Ещё продаёте?")
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, conversationId, message.ConversationId)
+			}
+		})
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	buyerId := uuid.NewV4()
+	sellerId := uuid.NewV4()
+	id := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		userId      uuid.UUID
+		recipientId uuid.UUID
+		readOnly    bool
+		expectedErr error
+	}{
+		{name: "Seller replies", userId: sellerId, recipientId: buyerId},
+		{name: "Buyer writes", userId: buyerId, recipientId: sellerId},
+		{name: "Stranger", userId: uuid.NewV4(), expectedErr: chat.ErrConversationNotFound},
+		{name: "Archived ad", userId: buyerId, readOnly: true, expectedErr: chat.ErrReadOnly},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockChatRepo(ctrl)
+			mockRepo.EXPECT().SelectConversation(gomock.Any(), id).
+				Return(models.Conversation{Id: id, BuyerId: buyerId, SellerId: sellerId, ReadOnly: tt.readOnly}, nil)
+			mockEvents := eventsMocks.NewMockPublisher(ctrl)
+			if tt.expectedErr == nil {
+				mockRepo.EXPECT().InsertMessage(gomock.Any(), id, tt.userId, "Да").Return(models.Message{ConversationId: id, SenderId: tt.userId}, nil)
+				mockEvents.EXPECT().Publish(gomock.Any(), tt.recipientId, gomock.Any())
+			}
+
+			uc := CreateChatUsecase(mockRepo, mockEvents)
+			_, err := uc.SendMessage(context.Background(), tt.userId, id, "Да")
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestGetMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buyerId := uuid.NewV4()
+	id := uuid.NewV4()
+	messages := []models.Message{{Id: uuid.NewV4()}, {Id: uuid.NewV4()}, {Id: uuid.NewV4()}}
+
+	mockRepo := mocks.NewMockChatRepo(ctrl)
+	mockRepo.EXPECT().SelectConversation(gomock.Any(), id).Return(models.Conversation{Id: id, BuyerId: buyerId, SellerId: uuid.NewV4()}, nil)
+	mockRepo.EXPECT().MarkRead(gomock.Any(), id, buyerId).Return(nil)
+	mockRepo.EXPECT().SelectMessages(gomock.Any(), id, uuid.Nil, 3).Return(messages, nil)
+	mockEvents := eventsMocks.NewMockPublisher(ctrl)
+
+	uc := CreateChatUsecase(mockRepo, mockEvents)
+	list, err := uc.GetMessages(context.Background(), buyerId, id, uuid.Nil, 2)
+
+	assert.NoError(t, err)
+	assert.True(t, list.HasNext)
+	assert.Equal(t, messages[:2], list.Messages)
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/currency"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+type CurrencyHandler struct {
+	uc currency.CurrencyUsecase
+}
+
+func CreateCurrencyHandler(uc currency.CurrencyUsecase) *CurrencyHandler {
+	return &CurrencyHandler{uc: uc}
+}
+
+// GetRates lists the currencies ads can be priced and shown in.
+func (h *CurrencyHandler) GetRates(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	rates, err := h.uc.GetRates(r.Context())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusInternalServerError)
+		sendErr.SendError(w, "failed to load exchange rates", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSON(w, loggerVar, rates, http.StatusOK)
+}
+
+// SetRate adds a currency or replaces its rate. The rate is the price of one
+// unit of the currency in rubles.
+func (h *CurrencyHandler) SetRate(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	code, ok := parseCurrency(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	var req models.ExchangeRateReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while unmarshaling JSON: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+		return
+	}
+	if !validation.ValidExchangeRate(req.Rate.String()) {
+		logger.LogHandlerError(loggerVar, currency.ErrInvalidRate, http.StatusBadRequest)
+		sendErr.SendError(w, currency.ErrInvalidRate.Error(), http.StatusBadRequest)
+		return
+	}
+
+	saved, err := h.uc.SetRate(r.Context(), models.ExchangeRate{Currency: code, Rate: req.Rate})
+	if err != nil {
+		sendCurrencyError(w, loggerVar, err)
+		return
+	}
+
+	sendJSON(w, loggerVar, saved, http.StatusOK)
+}
+
+func (h *CurrencyHandler) DeleteRate(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	code, ok := parseCurrency(w, r, loggerVar)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.DeleteRate(r.Context(), code); err != nil {
+		sendCurrencyError(w, loggerVar, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func parseCurrency(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger) (string, bool) {
+	code := mux.Vars(r)["currency"]
+	if !validation.ValidCurrency(code) {
+		logger.LogHandlerError(loggerVar, currency.ErrInvalidCurrency, http.StatusBadRequest)
+		sendErr.SendError(w, currency.ErrInvalidCurrency.Error(), http.StatusBadRequest)
+		return "", false
+	}
+	return code, true
+}
+
+func sendJSON(w http.ResponseWriter, loggerVar *slog.Logger, v interface{}, statusCode int) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+// sendCurrencyError maps errors returned by the currency usecase to HTTP
+// statuses.
+func sendCurrencyError(w http.ResponseWriter, loggerVar *slog.Logger, err error) {
+	var statusCode int
+	switch {
+	case errors.Is(err, currency.ErrBaseCurrency):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, currency.ErrCurrencyNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, currency.ErrCurrencyInUse):
+		statusCode = http.StatusConflict
+	case errors.Is(err, currency.ErrUpdatingRate), errors.Is(err, currency.ErrDeletingRate):
+		statusCode = http.StatusInternalServerError
+	default:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "unknown error", http.StatusInternalServerError)
+		return
+	}
+
+	logger.LogHandlerError(loggerVar, err, statusCode)
+	sendErr.SendError(w, err.Error(), statusCode)
+}
@@ -0,0 +1,30 @@
+package currency
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+)
+
+var (
+	ErrCurrencyNotFound = errors.New("currency not found")
+	ErrInvalidCurrency  = errors.New("invalid currency code")
+	ErrInvalidRate      = errors.New("invalid exchange rate")
+	ErrBaseCurrency     = errors.New("rate of the base currency can not be changed")
+	ErrCurrencyInUse    = errors.New("currency is used by ads")
+	ErrUpdatingRate     = errors.New("exchange rate update error")
+	ErrDeletingRate     = errors.New("exchange rate deletion error")
+)
+
+type CurrencyUsecase interface {
+	GetRates(ctx context.Context) (models.ExchangeRateList, error)
+	SetRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error)
+	DeleteRate(ctx context.Context, currency string) error
+}
+
+type CurrencyRepo interface {
+	SelectRates(ctx context.Context) (models.ExchangeRateList, error)
+	UpsertRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error)
+	DeleteRate(ctx context.Context, currency string) error
+}
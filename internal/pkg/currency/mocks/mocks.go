@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/currency/interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/pkg/currency/interfaces.go -destination=internal/pkg/currency/mocks/mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/go_vk_intern/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCurrencyUsecase is a mock of CurrencyUsecase interface.
+type MockCurrencyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCurrencyUsecaseMockRecorder is the mock recorder for MockCurrencyUsecase.
+type MockCurrencyUsecaseMockRecorder struct {
+	mock *MockCurrencyUsecase
+}
+
+// NewMockCurrencyUsecase creates a new mock instance.
+func NewMockCurrencyUsecase(ctrl *gomock.Controller) *MockCurrencyUsecase {
+	mock := &MockCurrencyUsecase{ctrl: ctrl}
+	mock.recorder = &MockCurrencyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyUsecase) EXPECT() *MockCurrencyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteRate mocks base method.
+func (m *MockCurrencyUsecase) DeleteRate(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRate", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRate indicates an expected call of DeleteRate.
+func (mr *MockCurrencyUsecaseMockRecorder) DeleteRate(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRate", reflect.TypeOf((*MockCurrencyUsecase)(nil).DeleteRate), ctx, currency)
+}
+
+// GetRates mocks base method.
+func (m *MockCurrencyUsecase) GetRates(ctx context.Context) (models.ExchangeRateList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx)
+	ret0, _ := ret[0].(models.ExchangeRateList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockCurrencyUsecaseMockRecorder) GetRates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockCurrencyUsecase)(nil).GetRates), ctx)
+}
+
+// SetRate mocks base method.
+func (m *MockCurrencyUsecase) SetRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRate", ctx, rate)
+	ret0, _ := ret[0].(models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRate indicates an expected call of SetRate.
+func (mr *MockCurrencyUsecaseMockRecorder) SetRate(ctx, rate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRate", reflect.TypeOf((*MockCurrencyUsecase)(nil).SetRate), ctx, rate)
+}
+
+// MockCurrencyRepo is a mock of CurrencyRepo interface.
+type MockCurrencyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockCurrencyRepoMockRecorder is the mock recorder for MockCurrencyRepo.
+type MockCurrencyRepoMockRecorder struct {
+	mock *MockCurrencyRepo
+}
+
+// NewMockCurrencyRepo creates a new mock instance.
+func NewMockCurrencyRepo(ctrl *gomock.Controller) *MockCurrencyRepo {
+	mock := &MockCurrencyRepo{ctrl: ctrl}
+	mock.recorder = &MockCurrencyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyRepo) EXPECT() *MockCurrencyRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteRate mocks base method.
+func (m *MockCurrencyRepo) DeleteRate(ctx context.Context, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRate", ctx, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRate indicates an expected call of DeleteRate.
+func (mr *MockCurrencyRepoMockRecorder) DeleteRate(ctx, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRate", reflect.TypeOf((*MockCurrencyRepo)(nil).DeleteRate), ctx, currency)
+}
+
+// SelectRates mocks base method.
+func (m *MockCurrencyRepo) SelectRates(ctx context.Context) (models.ExchangeRateList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectRates", ctx)
+	ret0, _ := ret[0].(models.ExchangeRateList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectRates indicates an expected call of SelectRates.
+func (mr *MockCurrencyRepoMockRecorder) SelectRates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectRates", reflect.TypeOf((*MockCurrencyRepo)(nil).SelectRates), ctx)
+}
+
+// UpsertRate mocks base method.
+func (m *MockCurrencyRepo) UpsertRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRate", ctx, rate)
+	ret0, _ := ret[0].(models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRate indicates an expected call of UpsertRate.
+func (mr *MockCurrencyRepoMockRecorder) UpsertRate(ctx, rate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRate", reflect.TypeOf((*MockCurrencyRepo)(nil).UpsertRate), ctx, rate)
+}
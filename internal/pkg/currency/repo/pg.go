@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/currency"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype/pgxtype"
+)
+
+type CurrencyRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateCurrencyRepo(db pgxtype.Querier) *CurrencyRepo {
+	return &CurrencyRepo{db: db}
+}
+
+//go:embed sql/selectRates.sql
+var selectRates string
+
+//go:embed sql/upsertRate.sql
+var upsertRate string
+
+//go:embed sql/deleteRate.sql
+var deleteRate string
+
+const foreignKeyViolation = "23503"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanRate reads a rate selected as text, numeric is never turned into a
+// float on the way.
+func scanRate(row scanner, rate *models.ExchangeRate) error {
+	var value string
+	if err := row.Scan(&rate.Currency, &value, &rate.UpdatedAt); err != nil {
+		return err
+	}
+	rate.Rate = json.Number(value)
+	return nil
+}
+
+func (r *CurrencyRepo) SelectRates(ctx context.Context) (models.ExchangeRateList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, selectRates)
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := make(models.ExchangeRateList, 0)
+	for rows.Next() {
+		var rate models.ExchangeRate
+		if err := scanRate(rows, &rate); err != nil {
+			loggerVar.Error("scan error: " + err.Error())
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// UpsertRate adds a currency or replaces its rate. Ads priced in it and
+// saved searches with bounds in it get their prices in rubles recomputed
+// with the new rate in the same statement.
+func (r *CurrencyRepo) UpsertRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var saved models.ExchangeRate
+	if err := scanRate(r.db.QueryRow(ctx, upsertRate, rate.Currency, rate.Rate.String()), &saved); err != nil {
+		loggerVar.Error(err.Error())
+		return models.ExchangeRate{}, currency.ErrUpdatingRate
+	}
+
+	loggerVar.Info("Successful")
+	return saved, nil
+}
+
+// DeleteRate removes a currency. Currencies of existing ads, deleted ones
+// included, are kept by the foreign key.
+func (r *CurrencyRepo) DeleteRate(ctx context.Context, code string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, deleteRate, code)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		loggerVar.Error(currency.ErrCurrencyInUse.Error())
+		return currency.ErrCurrencyInUse
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return currency.ErrDeletingRate
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(currency.ErrCurrencyNotFound.Error())
+		return currency.ErrCurrencyNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
@@ -0,0 +1 @@
+DELETE FROM exchange_rates WHERE currency = $1
\ No newline at end of file
@@ -0,0 +1,3 @@
+SELECT currency, trim_scale(rate)::text, updated_at
+FROM exchange_rates
+ORDER BY currency
\ No newline at end of file
@@ -0,0 +1,14 @@
+WITH rate AS (
+    INSERT INTO exchange_rates (currency, rate, updated_at)
+    VALUES ($1, $2::text::numeric, now())
+    ON CONFLICT (currency) DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at
+    RETURNING currency, rate, updated_at
+), repriced AS (
+    UPDATE ads SET base_price = round(ads.price * rate.rate)::bigint
+    FROM rate WHERE ads.currency = rate.currency
+), searches AS (
+    UPDATE saved_searches
+    SET base_price_range = int8range(round(price_min * rate.rate)::bigint, CASE WHEN price_max > 0 THEN round(price_max * rate.rate)::bigint END, '[]')
+    FROM rate WHERE saved_searches.currency = rate.currency
+)
+SELECT currency, trim_scale(rate)::text, updated_at FROM rate
\ No newline at end of file
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/currency"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+)
+
+type CurrencyUsecase struct {
+	repo currency.CurrencyRepo
+}
+
+func CreateCurrencyUsecase(repo currency.CurrencyRepo) *CurrencyUsecase {
+	return &CurrencyUsecase{repo: repo}
+}
+
+func (uc *CurrencyUsecase) GetRates(ctx context.Context) (models.ExchangeRateList, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rates, err := uc.repo.SelectRates(ctx)
+	if err != nil {
+		loggerVar.Error("error fetching rates: " + err.Error())
+		return nil, err
+	}
+	return rates, nil
+}
+
+// SetRate adds a currency or changes its rate. Prices of ads are converted
+// with the new rate right away. The base currency always stays at 1.
+func (uc *CurrencyUsecase) SetRate(ctx context.Context, rate models.ExchangeRate) (models.ExchangeRate, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if rate.Currency == models.BaseCurrency {
+		loggerVar.Error(currency.ErrBaseCurrency.Error())
+		return models.ExchangeRate{}, currency.ErrBaseCurrency
+	}
+
+	saved, err := uc.repo.UpsertRate(ctx, rate)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.ExchangeRate{}, err
+	}
+	return saved, nil
+}
+
+// DeleteRate removes a currency no ad is priced in.
+func (uc *CurrencyUsecase) DeleteRate(ctx context.Context, code string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if code == models.BaseCurrency {
+		loggerVar.Error(currency.ErrBaseCurrency.Error())
+		return currency.ErrBaseCurrency
+	}
+
+	if err := uc.repo.DeleteRate(ctx, code); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	return nil
+}
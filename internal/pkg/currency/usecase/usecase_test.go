@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/currency"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/currency/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		rate        models.ExchangeRate
+		repoMocker  func(*mocks.MockCurrencyRepo)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			rate: models.ExchangeRate{Currency: "USD", Rate: "92.5"},
+			repoMocker: func(repo *mocks.MockCurrencyRepo) {
+				repo.EXPECT().UpsertRate(gomock.Any(), models.ExchangeRate{Currency: "USD", Rate: "92.5"}).
+					Return(models.ExchangeRate{Currency: "USD", Rate: "92.5"}, nil)
+			},
+		},
+		{
+			name:        "Base currency",
+			rate:        models.ExchangeRate{Currency: models.BaseCurrency, Rate: "2"},
+			repoMocker:  func(*mocks.MockCurrencyRepo) {},
+			expectedErr: currency.ErrBaseCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockCurrencyRepo(ctrl)
+			tt.repoMocker(mockRepo)
+
+			uc := CreateCurrencyUsecase(mockRepo)
+			saved, err := uc.SetRate(context.Background(), tt.rate)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.rate.Rate, saved.Rate)
+			}
+		})
+	}
+}
+
+func TestDeleteRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        string
+		repoMocker  func(*mocks.MockCurrencyRepo)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			code: "USD",
+			repoMocker: func(repo *mocks.MockCurrencyRepo) {
+				repo.EXPECT().DeleteRate(gomock.Any(), "USD").Return(nil)
+			},
+		},
+		{
+			name: "In use",
+			code: "EUR",
+			repoMocker: func(repo *mocks.MockCurrencyRepo) {
+				repo.EXPECT().DeleteRate(gomock.Any(), "EUR").Return(currency.ErrCurrencyInUse)
+			},
+			expectedErr: currency.ErrCurrencyInUse,
+		},
+		{
+			name:        "Base currency",
+			code:        models.BaseCurrency,
+			repoMocker:  func(*mocks.MockCurrencyRepo) {},
+			expectedErr: currency.ErrBaseCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockCurrencyRepo(ctrl)
+			tt.repoMocker(mockRepo)
+
+			uc := CreateCurrencyUsecase(mockRepo)
+			err := uc.DeleteRate(context.Background(), tt.code)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/events"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
+)
+
+const (
+	// heartbeatInterval keeps idle streams from being cut by proxies and
+	// finds clients which went away without closing the connection.
+	heartbeatInterval = 25 * time.Second
+	// writeTimeout replaces the WriteTimeout of the server, which would
+	// cut every stream after the first seconds, with a deadline per write.
+	writeTimeout = 10 * time.Second
+	// retryDelay is how long clients wait before reconnecting, in
+	// milliseconds.
+	retryDelay = 3000
+)
+
+type EventsHandler struct {
+	hub events.Subscriber
+}
+
+func CreateEventsHandler(hub events.Subscriber) *EventsHandler {
+	return &EventsHandler{hub: hub}
+}
+
+// Stream pushes events of the user as Server-Sent Events until the client
+// goes away, falls behind or the server shuts down.
+func (h *EventsHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := jwtUtils.GetIdFromContext(r.Context())
+	if !ok {
+		logger.LogHandlerError(loggerVar, errors.New("error while getting user id from context"), http.StatusInternalServerError)
+		sendErr.SendError(w, "server error", http.StatusInternalServerError)
+		return
+	}
+
+	sub, err := h.hub.Subscribe(userId)
+	switch {
+	case errors.Is(err, events.ErrTooManyStreams):
+		logger.LogHandlerError(loggerVar, err, http.StatusTooManyRequests)
+		sendErr.SendError(w, err.Error(), http.StatusTooManyRequests)
+		return
+	case errors.Is(err, events.ErrHubClosed):
+		logger.LogHandlerError(loggerVar, err, http.StatusServiceUnavailable)
+		sendErr.SendError(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	case err != nil:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "unknown error", http.StatusInternalServerError)
+		return
+	}
+	defer h.hub.Unsubscribe(sub)
+
+	rc := http.NewResponseController(w)
+	// the request has been read, the ReadTimeout of the server would only
+	// cancel the stream
+	rc.SetReadDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := write(rc, w, fmt.Sprintf("retry: %d\n\n", retryDelay)); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while opening stream: %w", err), http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Stream opened", http.StatusOK)
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			loggerVar.Info("Stream closed by client")
+			return
+		case frame, ok := <-sub.Frames():
+			if !ok {
+				loggerVar.Info("Stream closed: " + sub.Err().Error())
+				return
+			}
+			if err := write(rc, w, fmt.Sprintf("event: %s\ndata: %s\n\n", frame.Type, frame.Data)); err != nil {
+				loggerVar.Error("write error: " + err.Error())
+				return
+			}
+		case <-heartbeat.C:
+			if err := write(rc, w, ": ping\n\n"); err != nil {
+				loggerVar.Error("write error: " + err.Error())
+				return
+			}
+		}
+	}
+}
+
+func write(rc *http.ResponseController, w io.Writer, data string) error {
+	rc.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := io.WriteString(w, data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/events"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withUser(userId uuid.UUID, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		next(w, r.WithContext(context.WithValue(r.Context(), jwtUtils.UserIdKey, userId.String())))
+	}
+}
+
+func TestStream(t *testing.T) {
+	userId := uuid.NewV4()
+	hub := events.CreateHub(4)
+	handler := CreateEventsHandler(hub)
+
+	srv := httptest.NewServer(withUser(userId, handler.Stream))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	lines := bufio.NewScanner(resp.Body)
+	readLine := func() string {
+		require.True(t, lines.Scan())
+		return lines.Text()
+	}
+	assert.Equal(t, "retry: 3000", readLine())
+	assert.Equal(t, "", readLine())
+
+	// the stream is subscribed once its first line is sent
+	hub.Publish(context.Background(), userId, models.Event{Type: models.EventMessage, Data: models.MessageResp{Text: "Ещё продаёте?"}})
+	assert.Equal(t, "event: message", readLine())
+	assert.Contains(t, readLine(), `"text":"Ещё продаёте?"`)
+	assert.Equal(t, "", readLine())
+
+	done := make(chan struct{})
+	go func() {
+		for lines.Scan() {
+		}
+		close(done)
+	}()
+	hub.Close()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("stream was not closed with the hub")
+	}
+}
+
+func TestStreamRefused(t *testing.T) {
+	userId := uuid.NewV4()
+
+	t.Run("Too many streams", func(t *testing.T) {
+		hub := events.CreateHub(1)
+		for i := 0; i < events.MaxStreamsPerUser; i++ {
+			_, err := hub.Subscribe(userId)
+			require.NoError(t, err)
+		}
+
+		rr := httptest.NewRecorder()
+		withUser(userId, CreateEventsHandler(hub).Stream)(rr, httptest.NewRequest(http.MethodGet, "/api/me/events", nil))
+
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	})
+
+	t.Run("Shutting down", func(t *testing.T) {
+		hub := events.CreateHub(1)
+		hub.Close()
+
+		rr := httptest.NewRecorder()
+		withUser(userId, CreateEventsHandler(hub).Stream)(rr, httptest.NewRequest(http.MethodGet, "/api/me/events", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
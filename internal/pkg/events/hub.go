@@ -0,0 +1,143 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+// MaxStreamsPerUser bounds the streams one user may keep open, e.g. one per
+// browser tab.
+const MaxStreamsPerUser = 5
+
+// Frame is an event with its data already encoded, so an event sent to
+// several streams is marshaled once.
+type Frame struct {
+	Type models.EventType
+	Data []byte
+}
+
+// Subscription is one open stream of a user.
+type Subscription struct {
+	userId uuid.UUID
+	frames chan Frame
+	// err is set by the hub before frames is closed.
+	err error
+}
+
+// Frames receives the events of the stream. It is closed when the stream
+// is unsubscribed, falls behind or the hub is closed, see Err.
+func (s *Subscription) Frames() <-chan Frame {
+	return s.frames
+}
+
+// Err tells why Frames was closed: ErrSlowConsumer, ErrHubClosed or nil if
+// the stream was unsubscribed. It is only meaningful once Frames is closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Hub fans events out to the open streams of every user. A stream which
+// does not keep up with its events is closed instead of slowing down the
+// publisher or buffering without limit, its client reconnects and reloads
+// what it missed from the regular endpoints.
+type Hub struct {
+	mu      sync.Mutex
+	streams map[uuid.UUID]map[*Subscription]struct{}
+	// buffer is how many events a stream may fall behind.
+	buffer int
+	closed bool
+}
+
+func CreateHub(buffer int) *Hub {
+	return &Hub{streams: make(map[uuid.UUID]map[*Subscription]struct{}), buffer: buffer}
+}
+
+func (h *Hub) Subscribe(userId uuid.UUID) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrHubClosed
+	}
+	subs := h.streams[userId]
+	if len(subs) >= MaxStreamsPerUser {
+		return nil, ErrTooManyStreams
+	}
+	if subs == nil {
+		subs = make(map[*Subscription]struct{})
+		h.streams[userId] = subs
+	}
+
+	sub := &Subscription{userId: userId, frames: make(chan Frame, h.buffer)}
+	subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// Unsubscribe closes a stream. Streams already closed by the hub are left
+// as they are.
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(sub, nil)
+}
+
+// remove must be called with mu held.
+func (h *Hub) remove(sub *Subscription, err error) {
+	subs := h.streams[sub.userId]
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.streams, sub.userId)
+	}
+	sub.err = err
+	close(sub.frames)
+}
+
+// Publish sends an event to every open stream of a user. Users without open
+// streams simply miss it.
+func (h *Hub) Publish(ctx context.Context, userId uuid.UUID, event models.Event) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		loggerVar.Error("marshal error: " + err.Error())
+		return
+	}
+	frame := Frame{Type: event.Type, Data: data}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.streams[userId] {
+		select {
+		case sub.frames <- frame:
+		default:
+			loggerVar.Error(ErrSlowConsumer.Error(), slog.String("user", userId.String()))
+			h.remove(sub, ErrSlowConsumer)
+		}
+	}
+}
+
+// Close ends every open stream and refuses new ones. It is run when the
+// server shuts down, as streams never end on their own.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, subs := range h.streams {
+		for sub := range subs {
+			h.remove(sub, ErrHubClosed)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHubFanOut(t *testing.T) {
+	hub := CreateHub(4)
+	userId := uuid.NewV4()
+
+	first, err := hub.Subscribe(userId)
+	assert.NoError(t, err)
+	second, err := hub.Subscribe(userId)
+	assert.NoError(t, err)
+	other, err := hub.Subscribe(uuid.NewV4())
+	assert.NoError(t, err)
+
+	hub.Publish(context.Background(), userId, models.Event{Type: models.EventAdModerated, Data: models.AdModeratedEvent{Status: models.AdStatusRejected, Reason: "bad image"}})
+
+	for _, sub := range []*Subscription{first, second} {
+		frame := <-sub.Frames()
+		assert.Equal(t, models.EventAdModerated, frame.Type)
+		assert.JSONEq(t, `{"ad_id": "00000000-0000-0000-0000-000000000000", "status": "rejected", "reason": "bad image"}`, string(frame.Data))
+	}
+	assert.Empty(t, other.Frames())
+}
+
+func TestHubSlowConsumer(t *testing.T) {
+	hub := CreateHub(2)
+	userId := uuid.NewV4()
+
+	slow, _ := hub.Subscribe(userId)
+	fast, _ := hub.Subscribe(userId)
+
+	event := models.Event{Type: models.EventMessage, Data: models.MessageResp{Text: "Привет"}}
+	for i := 0; i < 3; i++ {
+		hub.Publish(context.Background(), userId, event)
+		if i < 2 {
+			<-fast.Frames()
+		}
+	}
+
+	// the slow stream got its buffer full and was closed, the other one
+	// goes on
+	assert.Len(t, slow.Frames(), 2)
+	<-slow.Frames()
+	<-slow.Frames()
+	_, open := <-slow.Frames()
+	assert.False(t, open)
+	assert.ErrorIs(t, slow.Err(), ErrSlowConsumer)
+
+	frame, open := <-fast.Frames()
+	assert.True(t, open)
+	assert.Equal(t, models.EventMessage, frame.Type)
+
+	// unsubscribing a stream the hub already closed is harmless
+	hub.Unsubscribe(slow)
+	hub.Unsubscribe(fast)
+	_, open = <-fast.Frames()
+	assert.False(t, open)
+	assert.NoError(t, fast.Err())
+}
+
+func TestHubLimitsAndClose(t *testing.T) {
+	hub := CreateHub(1)
+	userId := uuid.NewV4()
+
+	subs := make([]*Subscription, 0, MaxStreamsPerUser)
+	for i := 0; i < MaxStreamsPerUser; i++ {
+		sub, err := hub.Subscribe(userId)
+		assert.NoError(t, err)
+		subs = append(subs, sub)
+	}
+	_, err := hub.Subscribe(userId)
+	assert.ErrorIs(t, err, ErrTooManyStreams)
+
+	hub.Close()
+	for _, sub := range subs {
+		_, open := <-sub.Frames()
+		assert.False(t, open)
+		assert.ErrorIs(t, sub.Err(), ErrHubClosed)
+	}
+
+	_, err = hub.Subscribe(uuid.NewV4())
+	assert.ErrorIs(t, err, ErrHubClosed)
+	hub.Publish(context.Background(), userId, models.Event{Type: models.EventMessage})
+}
@@ -0,0 +1,26 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrHubClosed      = errors.New("event streams are shut down")
+	ErrTooManyStreams = errors.New("too many open event streams")
+	ErrSlowConsumer   = errors.New("event stream fell behind")
+)
+
+// Publisher delivers events to the users they concern. Publish never
+// blocks, so it is safe to call from request handlers and workers.
+type Publisher interface {
+	Publish(ctx context.Context, userId uuid.UUID, event models.Event)
+}
+
+type Subscriber interface {
+	Subscribe(userId uuid.UUID) (*Subscription, error)
+	Unsubscribe(sub *Subscription)
+}
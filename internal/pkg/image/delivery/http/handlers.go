@@ -0,0 +1,188 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/sendErr"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+)
+
+// formField is the multipart field carrying the uploaded file.
+const formField = "image"
+
+// multipartOverhead leaves room for the boundaries and part headers around
+// a file of the maximum size.
+const multipartOverhead = 64 * 1024
+
+type ImageHandler struct {
+	uc image.ImageUsecase
+}
+
+func CreateImageHandler(uc image.ImageUsecase) *ImageHandler {
+	return &ImageHandler{uc: uc}
+}
+
+// UploadImage accepts a multipart/form-data request with the file in the
+// image field. The file is read in one pass and rejected as soon as it
+// grows over the size limit.
+func (h *ImageHandler) UploadImage(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userId, ok := jwtUtils.GetIdFromContext(r.Context())
+	if !ok {
+		logger.LogHandlerError(loggerVar, errors.New("error while getting user id from context"), http.StatusInternalServerError)
+		sendErr.SendError(w, "server error", http.StatusInternalServerError)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, validation.MaxImageSizeBytes+multipartOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while reading multipart form: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "multipart/form-data request expected", http.StatusBadRequest)
+		return
+	}
+
+	var data []byte
+	for data == nil {
+		part, err := reader.NextPart()
+		if err != nil {
+			sendReadError(w, loggerVar, err)
+			return
+		}
+		if part.FormName() != formField {
+			part.Close()
+			continue
+		}
+
+		data, err = io.ReadAll(io.LimitReader(part, validation.MaxImageSizeBytes+1))
+		part.Close()
+		if err != nil {
+			sendReadError(w, loggerVar, err)
+			return
+		}
+	}
+	if len(data) > validation.MaxImageSizeBytes {
+		sendImageError(w, loggerVar, image.ErrImageTooLarge)
+		return
+	}
+
+	img, err := h.uc.UploadImage(r.Context(), userId, data)
+	if err != nil {
+		sendImageError(w, loggerVar, err)
+		return
+	}
+
+	resp := models.ImageResp{
+		Id:          img.Id,
+		URL:         image.URL(img.Id, image.SizeOriginal),
+		Thumbnails:  image.ThumbnailURLs(img.Id),
+		ContentType: img.ContentType,
+		Width:       img.Width,
+		Height:      img.Height,
+		Size:        img.Size,
+		CreatedAt:   img.CreatedAt,
+	}
+	data, err = json.Marshal(resp)
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("marshal error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "response error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", resp.URL)
+	w.WriteHeader(http.StatusCreated)
+	w.Write(data)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+}
+
+// GetImage serves the cleaned up original or, with the size parameter, one
+// of the thumbnails. Stored files never change, so they are cached forever.
+func (h *ImageHandler) GetImage(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing image id: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "invalid image id", http.StatusBadRequest)
+		return
+	}
+	size := r.URL.Query().Get("size")
+	if size == "" {
+		size = image.SizeOriginal
+	}
+
+	file, img, err := h.uc.OpenImage(r.Context(), id, size)
+	if err != nil {
+		sendImageError(w, loggerVar, err)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", img.ContentType)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if size == image.SizeOriginal {
+		w.Header().Set("Content-Length", strconv.Itoa(img.Size))
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, file); err != nil {
+		loggerVar.Error("error writing image: " + err.Error())
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+// sendReadError reports a failure to read the request body. MaxBytesReader
+// cuts off bodies over the limit.
+func sendReadError(w http.ResponseWriter, loggerVar *slog.Logger, err error) {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		sendImageError(w, loggerVar, image.ErrImageTooLarge)
+	case errors.Is(err, io.EOF):
+		logger.LogHandlerError(loggerVar, errors.New("no image field"), http.StatusBadRequest)
+		sendErr.SendError(w, "image field is required", http.StatusBadRequest)
+	default:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while reading multipart form: %w", err), http.StatusBadRequest)
+		sendErr.SendError(w, "incorrect request", http.StatusBadRequest)
+	}
+}
+
+// sendImageError maps errors returned by the image usecase to HTTP
+// statuses.
+func sendImageError(w http.ResponseWriter, loggerVar *slog.Logger, err error) {
+	var statusCode int
+	switch {
+	case errors.Is(err, image.ErrInvalidImage):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, image.ErrImageNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, image.ErrImageTooLarge):
+		statusCode = http.StatusRequestEntityTooLarge
+	case errors.Is(err, image.ErrUnsupportedType):
+		statusCode = http.StatusUnsupportedMediaType
+	case errors.Is(err, image.ErrSavingImage):
+		statusCode = http.StatusInternalServerError
+	default:
+		logger.LogHandlerError(loggerVar, fmt.Errorf("unknkown error: %w", err), http.StatusInternalServerError)
+		sendErr.SendError(w, "unknown error", http.StatusInternalServerError)
+		return
+	}
+
+	logger.LogHandlerError(loggerVar, err, statusCode)
+	sendErr.SendError(w, err.Error(), statusCode)
+}
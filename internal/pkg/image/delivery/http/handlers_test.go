@@ -0,0 +1,101 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image/mocks"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/jwtUtils"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/validation"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func multipartBody(t *testing.T, field string, data []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, "photo.jpg")
+	assert.NoError(t, err)
+	part.Write(data)
+	assert.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestUploadImage(t *testing.T) {
+	userId := uuid.NewV4()
+	imageId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		field          string
+		data           []byte
+		usecaseMocker  func(*mocks.MockImageUsecase)
+		expectedStatus int
+	}{
+		{
+			name:  "Success",
+			field: "image",
+			data:  []byte("jpeg"),
+			usecaseMocker: func(mockUsecase *mocks.MockImageUsecase) {
+				mockUsecase.EXPECT().UploadImage(gomock.Any(), userId, []byte("jpeg")).
+					Return(models.Image{Id: imageId, ContentType: "image/jpeg"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "No image field",
+			field:          "file",
+			data:           []byte("jpeg"),
+			usecaseMocker:  func(*mocks.MockImageUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Too large",
+			field:          "image",
+			data:           make([]byte, validation.MaxImageSizeBytes+1),
+			usecaseMocker:  func(*mocks.MockImageUsecase) {},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:  "Unsupported type",
+			field: "image",
+			data:  []byte("GIF89a"),
+			usecaseMocker: func(mockUsecase *mocks.MockImageUsecase) {
+				mockUsecase.EXPECT().UploadImage(gomock.Any(), userId, gomock.Any()).Return(models.Image{}, image.ErrUnsupportedType)
+			},
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocks.NewMockImageUsecase(ctrl)
+			tt.usecaseMocker(mockUsecase)
+
+			body, contentType := multipartBody(t, tt.field, tt.data)
+			req := httptest.NewRequest(http.MethodPost, "/api/images", body)
+			req.Header.Set("Content-Type", contentType)
+			req = req.WithContext(context.WithValue(req.Context(), jwtUtils.UserIdKey, userId.String()))
+			rr := httptest.NewRecorder()
+			handler := CreateImageHandler(mockUsecase)
+
+			handler.UploadImage(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusCreated {
+				assert.Equal(t, image.URL(imageId, image.SizeOriginal), rr.Header().Get("Location"))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrImageNotFound   = errors.New("image not found")
+	ErrImageTooLarge   = errors.New("image is too large")
+	ErrUnsupportedType = errors.New("only jpeg and png images are supported")
+	ErrInvalidImage    = errors.New("invalid image")
+	ErrSavingImage     = errors.New("image saving error")
+)
+
+// SizeOriginal names the cleaned up original in the storage and in URLs,
+// the other sizes are thumbnails.
+const SizeOriginal = "original"
+
+// ThumbnailSizes maps thumbnail names to the longest side in pixels.
+// Images smaller than a thumbnail are never upscaled.
+var ThumbnailSizes = map[string]int{
+	"small":  160,
+	"medium": 480,
+	"large":  1024,
+}
+
+// URL is where an uploaded image is served, size is SizeOriginal or one of
+// ThumbnailSizes.
+func URL(id uuid.UUID, size string) string {
+	if size == SizeOriginal {
+		return "/api/images/" + id.String()
+	}
+	return "/api/images/" + id.String() + "?size=" + size
+}
+
+// ThumbnailURLs returns the URLs of every thumbnail of an image.
+func ThumbnailURLs(id uuid.UUID) map[string]string {
+	urls := make(map[string]string, len(ThumbnailSizes))
+	for size := range ThumbnailSizes {
+		urls[size] = URL(id, size)
+	}
+	return urls
+}
+
+// Storage keeps image files by key. Keys are built by the usecase from the
+// image id and the size, so they are always safe file names.
+type Storage interface {
+	Save(ctx context.Context, key string, data []byte) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type ImageUsecase interface {
+	UploadImage(ctx context.Context, userId uuid.UUID, data []byte) (models.Image, error)
+	OpenImage(ctx context.Context, id uuid.UUID, size string) (io.ReadCloser, models.Image, error)
+}
+
+type ImageRepo interface {
+	InsertImage(ctx context.Context, img models.Image) error
+	SelectImage(ctx context.Context, id uuid.UUID) (models.Image, error)
+}
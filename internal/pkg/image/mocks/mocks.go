@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/image/interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/pkg/image/interfaces.go -destination=internal/pkg/image/mocks/mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/go_vk_intern/internal/models"
+	uuid "github.com/satori/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorage)(nil).Delete), ctx, key)
+}
+
+// Open mocks base method.
+func (m *MockStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, key)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockStorageMockRecorder) Open(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockStorage)(nil).Open), ctx, key)
+}
+
+// Save mocks base method.
+func (m *MockStorage) Save(ctx context.Context, key string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStorageMockRecorder) Save(ctx, key, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStorage)(nil).Save), ctx, key, data)
+}
+
+// MockImageUsecase is a mock of ImageUsecase interface.
+type MockImageUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockImageUsecaseMockRecorder is the mock recorder for MockImageUsecase.
+type MockImageUsecaseMockRecorder struct {
+	mock *MockImageUsecase
+}
+
+// NewMockImageUsecase creates a new mock instance.
+func NewMockImageUsecase(ctrl *gomock.Controller) *MockImageUsecase {
+	mock := &MockImageUsecase{ctrl: ctrl}
+	mock.recorder = &MockImageUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageUsecase) EXPECT() *MockImageUsecaseMockRecorder {
+	return m.recorder
+}
+
+// OpenImage mocks base method.
+func (m *MockImageUsecase) OpenImage(ctx context.Context, id uuid.UUID, size string) (io.ReadCloser, models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenImage", ctx, id, size)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(models.Image)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenImage indicates an expected call of OpenImage.
+func (mr *MockImageUsecaseMockRecorder) OpenImage(ctx, id, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenImage", reflect.TypeOf((*MockImageUsecase)(nil).OpenImage), ctx, id, size)
+}
+
+// UploadImage mocks base method.
+func (m *MockImageUsecase) UploadImage(ctx context.Context, userId uuid.UUID, data []byte) (models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImage", ctx, userId, data)
+	ret0, _ := ret[0].(models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImage indicates an expected call of UploadImage.
+func (mr *MockImageUsecaseMockRecorder) UploadImage(ctx, userId, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockImageUsecase)(nil).UploadImage), ctx, userId, data)
+}
+
+// MockImageRepo is a mock of ImageRepo interface.
+type MockImageRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockImageRepoMockRecorder is the mock recorder for MockImageRepo.
+type MockImageRepoMockRecorder struct {
+	mock *MockImageRepo
+}
+
+// NewMockImageRepo creates a new mock instance.
+func NewMockImageRepo(ctrl *gomock.Controller) *MockImageRepo {
+	mock := &MockImageRepo{ctrl: ctrl}
+	mock.recorder = &MockImageRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageRepo) EXPECT() *MockImageRepoMockRecorder {
+	return m.recorder
+}
+
+// InsertImage mocks base method.
+func (m *MockImageRepo) InsertImage(ctx context.Context, img models.Image) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertImage", ctx, img)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertImage indicates an expected call of InsertImage.
+func (mr *MockImageRepoMockRecorder) InsertImage(ctx, img any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertImage", reflect.TypeOf((*MockImageRepo)(nil).InsertImage), ctx, img)
+}
+
+// SelectImage mocks base method.
+func (m *MockImageRepo) SelectImage(ctx context.Context, id uuid.UUID) (models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectImage", ctx, id)
+	ret0, _ := ret[0].(models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectImage indicates an expected call of SelectImage.
+func (mr *MockImageRepoMockRecorder) SelectImage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectImage", reflect.TypeOf((*MockImageRepo)(nil).SelectImage), ctx, id)
+}
@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+type ImageRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateImageRepo(db pgxtype.Querier) *ImageRepo {
+	return &ImageRepo{db: db}
+}
+
+//go:embed sql/insertImage.sql
+var insertImage string
+
+//go:embed sql/selectImage.sql
+var selectImage string
+
+func (r *ImageRepo) InsertImage(ctx context.Context, img models.Image) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, insertImage, img.Id, img.UserId, img.ContentType, img.Width, img.Height, img.Size, img.CreatedAt)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return image.ErrSavingImage
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (r *ImageRepo) SelectImage(ctx context.Context, id uuid.UUID) (models.Image, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var img models.Image
+	err := r.db.QueryRow(ctx, selectImage, id).Scan(&img.Id, &img.UserId, &img.ContentType, &img.Width, &img.Height, &img.Size, &img.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(image.ErrImageNotFound.Error())
+		return models.Image{}, image.ErrImageNotFound
+	}
+	if err != nil {
+		loggerVar.Error("query error: " + err.Error())
+		return models.Image{}, err
+	}
+
+	return img, nil
+}
@@ -0,0 +1,2 @@
+INSERT INTO images (id, user_id, content_type, width, height, size_bytes, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
\ No newline at end of file
@@ -0,0 +1,3 @@
+SELECT id, user_id, content_type, width, height, size_bytes, created_at
+FROM images
+WHERE id = $1
\ No newline at end of file
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	s, err := CreateLocalStorage(t.TempDir())
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Save(ctx, "abc/original", []byte("data")))
+
+	f, err := s.Open(ctx, "abc/original")
+	if assert.NoError(t, err) {
+		data, _ := io.ReadAll(f)
+		f.Close()
+		assert.Equal(t, "data", string(data))
+	}
+
+	assert.NoError(t, s.Delete(ctx, "abc/original"))
+	assert.NoError(t, s.Delete(ctx, "abc/original"))
+	_, err = s.Open(ctx, "abc/original")
+	assert.ErrorIs(t, err, image.ErrImageNotFound)
+
+	assert.Error(t, s.Save(ctx, "../escape", []byte("data")))
+}
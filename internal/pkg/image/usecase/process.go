@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	stdimage "image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
+)
+
+const (
+	// maxImagePixels guards against small files which decode into huge
+	// bitmaps.
+	maxImagePixels = 40_000_000
+	jpegQuality    = 90
+)
+
+// processed is an uploaded image decoded and encoded again. Re-encoding
+// drops EXIF and any other metadata the original carried.
+type processed struct {
+	contentType string
+	width       int
+	height      int
+	// files holds the encoded original and thumbnails by size name.
+	files map[string][]byte
+}
+
+func processImage(data []byte) (processed, error) {
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return processed{}, image.ErrUnsupportedType
+	}
+
+	cfg, _, err := stdimage.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return processed{}, fmt.Errorf("%w: %s", image.ErrInvalidImage, err.Error())
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return processed{}, image.ErrImageTooLarge
+	}
+
+	img, _, err := stdimage.Decode(bytes.NewReader(data))
+	if err != nil {
+		return processed{}, fmt.Errorf("%w: %s", image.ErrInvalidImage, err.Error())
+	}
+	if contentType == "image/jpeg" {
+		// the orientation is lost with the rest of EXIF, so it is applied
+		// to the pixels
+		img = orient(img, exifOrientation(data))
+	}
+
+	result := processed{
+		contentType: contentType,
+		width:       img.Bounds().Dx(),
+		height:      img.Bounds().Dy(),
+		files:       make(map[string][]byte, len(image.ThumbnailSizes)+1),
+	}
+	if result.files[image.SizeOriginal], err = encode(img, contentType); err != nil {
+		return processed{}, err
+	}
+	// every thumbnail is scaled from the same RGBA copy of the original, a
+	// copy per size would multiply the memory a large upload takes
+	var src *stdimage.RGBA
+	for size, maxSide := range image.ThumbnailSizes {
+		thumbnail := img
+		if result.width > maxSide || result.height > maxSide {
+			if src == nil {
+				src = toRGBA(img)
+			}
+			thumbnail = resize(src, maxSide)
+		}
+		if result.files[size], err = encode(thumbnail, contentType); err != nil {
+			return processed{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func encode(img stdimage.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// toRGBA returns img as an RGBA bitmap starting at 0,0, copying it only if
+// it is not one already.
+func toRGBA(img stdimage.Image) *stdimage.RGBA {
+	b := img.Bounds()
+	if rgba, ok := img.(*stdimage.RGBA); ok && b.Min == (stdimage.Point{}) {
+		return rgba
+	}
+	rgba := stdimage.NewRGBA(stdimage.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
+
+// resize scales src down to fit into a maxSide square keeping the aspect
+// ratio. Every destination pixel is the average of the source pixels it
+// covers, which is good enough for thumbnails.
+func resize(src *stdimage.RGBA, maxSide int) *stdimage.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := maxSide, max(1, h*maxSide/w)
+	if h > w {
+		dw, dh = max(1, w*maxSide/h), maxSide
+	}
+
+	dst := stdimage.NewRGBA(stdimage.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := y*h/dh, max((y+1)*h/dh, y*h/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := x*w/dw, max((x+1)*w/dw, x*w/dw+1)
+
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[src.PixOffset(x0, sy):src.PixOffset(x1, sy)]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+
+			n := (y1 - y0) * (x1 - x0)
+			i := dst.PixOffset(x, y)
+			for c := range sum {
+				dst.Pix[i+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+	return dst
+}
+
+// orient turns img the way EXIF orientation o says it must be displayed.
+func orient(img stdimage.Image, o int) stdimage.Image {
+	if o < 2 || o > 8 {
+		return img
+	}
+
+	src := toRGBA(img)
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if o >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := stdimage.NewRGBA(stdimage.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch o {
+			case 2: // mirrored horizontally
+				dx, dy = w-1-x, y
+			case 3: // rotated 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // needs 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // needs 90 counterclockwise
+				dx, dy = y, w-1-x
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(x, y):src.PixOffset(x, y)+4])
+		}
+	}
+	return dst
+}
+
+// exifOrientation reads the orientation tag from the EXIF segment of a
+// JPEG. Anything missing or malformed means the default orientation 1.
+func exifOrientation(data []byte) int {
+	const orientationTag = 0x0112
+
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data) && data[i] == 0xFF; {
+		marker := data[i+1]
+		// the image data starts at SOS, EXIF is always before it
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		if length < 2 || i+2+length > len(data) {
+			break
+		}
+		segment := data[i+4 : i+2+length]
+		i += 2 + length
+
+		if marker != 0xE1 || !bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			continue
+		}
+		tiff := segment[6:]
+		if len(tiff) < 8 {
+			return 1
+		}
+
+		var order binary.ByteOrder
+		switch string(tiff[:2]) {
+		case "II":
+			order = binary.LittleEndian
+		case "MM":
+			order = binary.BigEndian
+		default:
+			return 1
+		}
+
+		offset := int(order.Uint32(tiff[4:8]))
+		if offset < 8 || offset+2 > len(tiff) {
+			return 1
+		}
+		count := int(order.Uint16(tiff[offset : offset+2]))
+		for e := 0; e < count; e++ {
+			entry := offset + 2 + e*12
+			if entry+12 > len(tiff) {
+				return 1
+			}
+			if order.Uint16(tiff[entry:entry+2]) == orientationTag {
+				return int(order.Uint16(tiff[entry+8 : entry+10]))
+			}
+		}
+		return 1
+	}
+
+	return 1
+}
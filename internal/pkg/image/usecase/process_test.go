@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	stdimage "image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := stdimage.NewRGBA(stdimage.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+// withOrientation inserts an EXIF segment with the given orientation right
+// after the SOI marker of a jpeg.
+func withOrientation(data []byte, orientation uint16) []byte {
+	var tiff bytes.Buffer
+	tiff.WriteString("MM\x00\x2a")
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{0x0112, 3})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, segment...)
+	return append(result, data[2:]...)
+}
+
+func decodeSize(t *testing.T, data []byte) (int, int) {
+	t.Helper()
+	cfg, _, err := stdimage.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	return cfg.Width, cfg.Height
+}
+
+func TestProcessImageThumbnails(t *testing.T) {
+	result, err := processImage(encodeJPEG(t, 2000, 1000))
+	require.NoError(t, err)
+
+	assert.Equal(t, "image/jpeg", result.contentType)
+	assert.Equal(t, 2000, result.width)
+	assert.Equal(t, 1000, result.height)
+
+	want := map[string][2]int{
+		image.SizeOriginal: {2000, 1000},
+		"large":            {1024, 512},
+		"medium":           {480, 240},
+		"small":            {160, 80},
+	}
+	require.Len(t, result.files, len(want))
+	for size, dims := range want {
+		width, height := decodeSize(t, result.files[size])
+		assert.Equal(t, dims, [2]int{width, height}, size)
+	}
+}
+
+func TestProcessImageNoUpscale(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, stdimage.NewRGBA(stdimage.Rect(0, 0, 100, 50))))
+
+	result, err := processImage(buf.Bytes())
+	require.NoError(t, err)
+
+	assert.Equal(t, "image/png", result.contentType)
+	for size, data := range result.files {
+		width, height := decodeSize(t, data)
+		assert.Equal(t, [2]int{100, 50}, [2]int{width, height}, size)
+	}
+}
+
+func TestProcessImageExif(t *testing.T) {
+	result, err := processImage(withOrientation(encodeJPEG(t, 200, 100), 6))
+	require.NoError(t, err)
+
+	// orientation 6 is a 90° turn, the pixels are rotated and EXIF is gone
+	assert.Equal(t, 100, result.width)
+	assert.Equal(t, 200, result.height)
+	width, height := decodeSize(t, result.files[image.SizeOriginal])
+	assert.Equal(t, [2]int{100, 200}, [2]int{width, height})
+	assert.False(t, bytes.Contains(result.files[image.SizeOriginal], []byte("Exif")))
+}
+
+func TestProcessImageRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"gif", []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00;"), image.ErrUnsupportedType},
+		{"text", []byte("hello"), image.ErrUnsupportedType},
+		{"broken jpeg", []byte("\xFF\xD8\xFF\xE0 broken"), image.ErrInvalidImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := processImage(tt.data)
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
+
+func TestToRGBAReusesBitmap(t *testing.T) {
+	rgba := stdimage.NewRGBA(stdimage.Rect(0, 0, 10, 10))
+	assert.Same(t, rgba, toRGBA(rgba))
+
+	// a sub-image does not start at 0,0 and is copied
+	sub := rgba.SubImage(stdimage.Rect(2, 2, 6, 6))
+	assert.Equal(t, stdimage.Rect(0, 0, 4, 4), toRGBA(sub).Bounds())
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/image"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+type ImageUsecase struct {
+	repo    image.ImageRepo
+	storage image.Storage
+}
+
+func CreateImageUsecase(repo image.ImageRepo, storage image.Storage) *ImageUsecase {
+	return &ImageUsecase{repo: repo, storage: storage}
+}
+
+func storageKey(id uuid.UUID, size string) string {
+	return id.String() + "/" + size
+}
+
+// UploadImage cleans up an uploaded image, stores it with its thumbnails
+// and records the metadata. Files of a failed upload are removed.
+func (uc *ImageUsecase) UploadImage(ctx context.Context, userId uuid.UUID, data []byte) (models.Image, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	result, err := processImage(data)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Image{}, err
+	}
+
+	img := models.Image{
+		Id:          uuid.NewV4(),
+		UserId:      userId,
+		ContentType: result.contentType,
+		Width:       result.width,
+		Height:      result.height,
+		Size:        len(result.files[image.SizeOriginal]),
+		CreatedAt:   time.Now(),
+	}
+
+	saved := make([]string, 0, len(result.files))
+	cleanup := func() {
+		for _, key := range saved {
+			if err := uc.storage.Delete(ctx, key); err != nil {
+				loggerVar.Error("error deleting " + key + ": " + err.Error())
+			}
+		}
+	}
+
+	for size, file := range result.files {
+		key := storageKey(img.Id, size)
+		if err := uc.storage.Save(ctx, key, file); err != nil {
+			loggerVar.Error(err.Error())
+			cleanup()
+			return models.Image{}, image.ErrSavingImage
+		}
+		saved = append(saved, key)
+	}
+
+	if err := uc.repo.InsertImage(ctx, img); err != nil {
+		loggerVar.Error(err.Error())
+		cleanup()
+		return models.Image{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return img, nil
+}
+
+// OpenImage returns the file of an image in the given size along with the
+// image metadata. The caller closes the file.
+func (uc *ImageUsecase) OpenImage(ctx context.Context, id uuid.UUID, size string) (io.ReadCloser, models.Image, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, ok := image.ThumbnailSizes[size]; !ok && size != image.SizeOriginal {
+		loggerVar.Error(image.ErrImageNotFound.Error())
+		return nil, models.Image{}, image.ErrImageNotFound
+	}
+
+	img, err := uc.repo.SelectImage(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, models.Image{}, err
+	}
+
+	file, err := uc.storage.Open(ctx, storageKey(id, size))
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, models.Image{}, err
+	}
+
+	return file, img, nil
+}
@@ -50,7 +50,24 @@ func AuthMiddleware(loggerVar *slog.Logger) mux.MiddlewareFunc {
 
 			ctx := context.WithValue(r.Context(), jwtUtils.UserIdKey, id)
 			ctx = context.WithValue(ctx, jwtUtils.UserLoginKey, login)
+			ctx = context.WithValue(ctx, jwtUtils.UserIsAdminKey, jwtUtils.GetIsAdminFromJWT(parts[1], secret))
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// AdminMiddleware lets through only admins. It relies on AuthMiddleware
+// having put the is_admin claim into the request context.
+func AdminMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+		if !jwtUtils.GetIsAdminFromContext(r.Context()) {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("admin rights required"), http.StatusForbidden)
+			sendErr.SendError(w, "admin rights required", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,72 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/mailru/easyjson"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Encode turns a cursor into an opaque url-safe token.
+func Encode(c models.Cursor) (string, error) {
+	data, err := easyjson.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func Decode(token string) (models.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return models.Cursor{}, ErrInvalidCursor
+	}
+
+	var c models.Cursor
+	if err := easyjson.Unmarshal(data, &c); err != nil {
+		return models.Cursor{}, ErrInvalidCursor
+	}
+	if c.SortBy != "price" && c.SortBy != "created_at" {
+		return models.Cursor{}, ErrInvalidCursor
+	}
+	if c.Order != "asc" && c.Order != "desc" {
+		return models.Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// FromAd builds the cursor pointing right after ad for the sorting and the
+// currency of filter.
+func FromAd(ad models.Ad, filter models.Filter) models.Cursor {
+	c := models.Cursor{SortBy: filter.SortBy, Order: filter.Order, Currency: currencyOf(filter), Id: ad.Id}
+	switch filter.SortBy {
+	case "price":
+		// ads are sorted by the price in the base currency
+		c.Price = ad.BasePrice
+	case "created_at":
+		c.CreatedAt = ad.CreatedAt
+	}
+	return c
+}
+
+// Matches reports whether c was made for the currency of filter. Price
+// bounds are converted from that currency, so a cursor of another one would
+// continue a different result set.
+func Matches(c models.Cursor, filter models.Filter) bool {
+	return c.Currency == currencyOf(filter)
+}
+
+func currencyOf(filter models.Filter) string {
+	if filter.Currency == "" {
+		return models.BaseCurrency
+	}
+	return filter.Currency
+}
+
+// Supported reports whether keyset pagination is possible for sortBy.
+func Supported(sortBy string) bool {
+	return sortBy == "price" || sortBy == "created_at"
+}
@@ -0,0 +1,81 @@
+package cursor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	ad := models.Ad{
+		Id:        uuid.NewV4(),
+		Price:     1999,
+		BasePrice: 184908,
+		CreatedAt: time.Date(2025, 7, 1, 12, 30, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name   string
+		sortBy string
+		order  string
+	}{
+		{"price asc", "price", "asc"},
+		{"price desc", "price", "desc"},
+		{"created_at asc", "created_at", "asc"},
+		{"created_at desc", "created_at", "desc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Encode(FromAd(ad, models.Filter{SortBy: tt.sortBy, Order: tt.order, Currency: "USD"}))
+			assert.NoError(t, err)
+
+			c, err := Decode(token)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.sortBy, c.SortBy)
+			assert.Equal(t, tt.order, c.Order)
+			assert.Equal(t, ad.Id, c.Id)
+			assert.Equal(t, "USD", c.Currency)
+			if tt.sortBy == "price" {
+				assert.Equal(t, ad.BasePrice, c.Price)
+			} else {
+				assert.True(t, ad.CreatedAt.Equal(c.CreatedAt))
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	relevance, err := Encode(models.Cursor{SortBy: "relevance", Order: "desc", Id: uuid.NewV4()})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "!!!"},
+		{"not json", "bm90IGpzb24"},
+		{"unsupported sorting", relevance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.token)
+			assert.Equal(t, ErrInvalidCursor, err)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	ad := models.Ad{Id: uuid.NewV4(), BasePrice: 1999}
+	rub := FromAd(ad, models.Filter{SortBy: "price", Order: "asc"})
+
+	assert.Equal(t, models.BaseCurrency, rub.Currency)
+	assert.True(t, Matches(rub, models.Filter{}))
+	assert.True(t, Matches(rub, models.Filter{Currency: models.BaseCurrency}))
+	assert.False(t, Matches(rub, models.Filter{Currency: "USD"}))
+	assert.False(t, Matches(models.Cursor{SortBy: "price", Order: "asc", Id: ad.Id}, models.Filter{}))
+}
@@ -0,0 +1,254 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrInvalidURL       = errors.New("invalid image url")
+	ErrForbiddenAddress = errors.New("image url points to a forbidden address")
+	ErrTooManyRedirects = errors.New("too many redirects")
+	ErrTooLarge         = errors.New("image size exceeds limit")
+	ErrUnsupportedType  = errors.New("unsupported image type")
+	ErrUnavailable      = errors.New("image is unavailable")
+)
+
+const (
+	timeout         = 10 * time.Second
+	maxRedirects    = 3
+	cacheTTL        = 10 * time.Minute
+	maxCacheEntries = 1024
+	// sniffLength is how much http.DetectContentType looks at.
+	sniffLength = 512
+)
+
+// deniedPrefixes are ranges not covered by the netip predicates which
+// still must not be reachable from the server.
+var deniedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Denied reports whether addr is loopback, private, link-local or any other
+// internal address a user supplied URL must not reach.
+func Denied(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return true
+	}
+	for _, prefix := range deniedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Info describes a fetched resource.
+type Info struct {
+	ContentType string
+	Size        int64
+}
+
+type cacheEntry struct {
+	info    Info
+	err     error
+	expires time.Time
+}
+
+// Fetcher downloads user supplied URLs once, checking the content type and
+// the size on the fly. Host names are resolved by the fetcher itself and
+// the connection goes to the checked IP, so a DNS answer can not change
+// between the check and the request. Every redirect is checked the same way.
+type Fetcher struct {
+	client       *http.Client
+	maxSize      int64
+	contentTypes []string
+	// denied is swapped in tests, httptest servers listen on loopback.
+	denied func(netip.Addr) bool
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// CreateFetcher returns a fetcher accepting resources of up to maxSize bytes
+// whose sniffed type is one of contentTypes.
+func CreateFetcher(maxSize int64, contentTypes []string) *Fetcher {
+	f := &Fetcher{
+		maxSize:      maxSize,
+		contentTypes: contentTypes,
+		denied:       Denied,
+		cache:        make(map[string]cacheEntry),
+	}
+	f.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// a proxy from the environment would connect on our behalf to
+			// addresses nobody checked
+			Proxy:               nil,
+			DialContext:         f.dial,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     time.Minute,
+		},
+		CheckRedirect: checkRedirect,
+	}
+	return f
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > maxRedirects {
+		return ErrTooManyRedirects
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+// dial resolves the host and connects to one of its addresses. A host with
+// any denied address is refused as a whole.
+func (f *Fetcher) dial(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, err
+	}
+	for i := range addrs {
+		// the resolver may return IPv4 addresses in the IPv6 form
+		addrs[i] = addrs[i].Unmap()
+		if f.denied(addrs[i]) {
+			return nil, ErrForbiddenAddress
+		}
+	}
+
+	var dialer net.Dialer
+	for _, addr := range addrs {
+		var conn net.Conn
+		conn, err = dialer.DialContext(ctx, network, net.JoinHostPort(addr.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// Fetch downloads link and returns its sniffed content type and size.
+// Results, failed checks included, are cached per URL for a while, so
+// validating the same link again does not download it again. Network
+// failures are not cached.
+func (f *Fetcher) Fetch(ctx context.Context, link string) (Info, error) {
+	if entry, ok := f.cached(link); ok {
+		return entry.info, entry.err
+	}
+
+	info, err := f.fetch(ctx, link)
+	if err == nil || !errors.Is(err, ErrUnavailable) {
+		f.store(link, cacheEntry{info: info, err: err, expires: time.Now().Add(cacheTTL)})
+	}
+	return info, err
+}
+
+func (f *Fetcher) fetch(ctx context.Context, link string) (Info, error) {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+		return Info{}, ErrInvalidURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Info{}, ErrInvalidURL
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		for _, known := range []error{ErrForbiddenAddress, ErrTooManyRedirects, ErrInvalidURL} {
+			if errors.Is(err, known) {
+				return Info{}, known
+			}
+		}
+		// the cause is not reported, it would let users probe the network
+		return Info{}, ErrUnavailable
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Info{}, ErrUnavailable
+	}
+	if resp.ContentLength > f.maxSize {
+		return Info{}, ErrTooLarge
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(resp.Body, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return Info{}, ErrUnavailable
+	}
+	contentType := http.DetectContentType(head[:n])
+	if !slices.Contains(f.contentTypes, contentType) {
+		return Info{}, ErrUnsupportedType
+	}
+
+	// the rest is only counted, reading stops right after the limit
+	rest, err := io.Copy(io.Discard, io.LimitReader(resp.Body, f.maxSize-int64(n)+1))
+	if err != nil {
+		return Info{}, ErrUnavailable
+	}
+	size := int64(n) + rest
+	if size > f.maxSize {
+		return Info{}, ErrTooLarge
+	}
+
+	return Info{ContentType: contentType, Size: size}, nil
+}
+
+func (f *Fetcher) cached(link string) (cacheEntry, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry, ok := f.cache[link]
+	if !ok || time.Now().After(entry.expires) {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (f *Fetcher) store(link string, entry cacheEntry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.cache) >= maxCacheEntries {
+		now := time.Now()
+		for key, old := range f.cache {
+			if now.After(old.expires) {
+				delete(f.cache, key)
+			}
+		}
+	}
+	// still full: drop an arbitrary entry, the cache only saves downloads
+	for key := range f.cache {
+		if len(f.cache) < maxCacheEntries {
+			break
+		}
+		delete(f.cache, key)
+	}
+	f.cache[link] = entry
+}
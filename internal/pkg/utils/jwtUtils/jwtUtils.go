@@ -17,8 +17,9 @@ import (
 type CtxKey string
 
 const (
-	UserIdKey CtxKey = "id"
-	UserLoginKey CtxKey = "login"
+	UserIdKey      CtxKey = "id"
+	UserLoginKey   CtxKey = "login"
+	UserIsAdminKey CtxKey = "is_admin"
 )
 
 
@@ -29,9 +30,10 @@ func GenerateToken(user models.User) (string, error) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"id":    user.Id,
-		"login": user.Login,
-		"exp":   time.Now().Add(24 * time.Hour).Unix(),
+		"id":       user.Id,
+		"login":    user.Login,
+		"is_admin": user.IsAdmin,
+		"exp":      time.Now().Add(24 * time.Hour).Unix(),
 	})
 
 	return token.SignedString([]byte(secret))
@@ -75,6 +77,27 @@ func GetLoginFromJWT(JWTStr string, secret string) (string, bool) {
 	return login, ok
 }
 
+// GetIsAdminFromJWT reports whether the token belongs to an admin. Tokens
+// issued before the claim existed are treated as non-admin.
+func GetIsAdminFromJWT(JWTStr string, secret string) bool {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(JWTStr, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		if secret == "" {
+			return nil, fmt.Errorf("JWT_SECRET is not set")
+		}
+		return []byte(secret), nil
+	})
+	if err != nil || !token.Valid {
+		return false
+	}
+
+	isAdmin, _ := claims["is_admin"].(bool)
+	return isAdmin
+}
+
 func GetIdFromContext(ctx context.Context) (uuid.UUID, bool) {
 	id, err := uuid.FromString(ctx.Value(UserIdKey).(string))
 	if err != nil {
@@ -88,6 +111,11 @@ func GetLoginFromContext(ctx context.Context) (string, bool) {
 	return login, ok
 }
 
+func GetIsAdminFromContext(ctx context.Context) bool {
+	isAdmin, _ := ctx.Value(UserIsAdminKey).(bool)
+	return isAdmin
+}
+
 func GenerateJWTForTest(t *testing.T, login string, secret string) string {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"login":  login,
@@ -53,3 +53,19 @@ func TestGenerateJWTForTest(t *testing.T) {
 	tokenStr := GenerateJWTForTest(t, login, secret)
 	assert.NotNil(t, tokenStr)
 }
+
+func TestGetIsAdminFromJWT(t *testing.T) {
+	admin := createTestJWT(t, jwt.MapClaims{
+		"is_admin": true,
+		"exp":      time.Now().Add(time.Hour).Unix(),
+	}, secret)
+	assert.True(t, GetIsAdminFromJWT(admin, secret))
+
+	legacy := createTestJWT(t, jwt.MapClaims{
+		"login": "test2025",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}, secret)
+	assert.False(t, GetIsAdminFromJWT(legacy, secret))
+
+	assert.False(t, GetIsAdminFromJWT(admin, "other_secret"))
+}
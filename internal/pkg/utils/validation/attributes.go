@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+)
+
+const (
+	maxAttributeNameLength   = 32
+	maxAttributeStringLength = 100
+	maxAttributesPerCategory = 50
+	attributeNameChars       = "abcdefghijklmnopqrstuvwxyz0123456789_"
+)
+
+// ValidAttributeName allows lower case latin letters, digits and
+// underscores, so names are safe to use in query parameters like attr.rooms.
+func ValidAttributeName(name string) bool {
+	if len(name) == 0 || len(name) > maxAttributeNameLength {
+		return false
+	}
+	if name[0] < 'a' || name[0] > 'z' {
+		return false
+	}
+	for _, char := range name {
+		if !strings.ContainsRune(attributeNameChars, char) {
+			return false
+		}
+	}
+	return true
+}
+
+func ValidateAttributeSchema(schema models.AttributeSchemaList) error {
+	if len(schema) > maxAttributesPerCategory {
+		return errors.New("too many attributes")
+	}
+
+	seen := make(map[string]bool, len(schema))
+	for _, attr := range schema {
+		if !ValidAttributeName(attr.Name) {
+			return fmt.Errorf("invalid attribute name %q", attr.Name)
+		}
+		if seen[attr.Name] {
+			return fmt.Errorf("duplicate attribute %s", attr.Name)
+		}
+		seen[attr.Name] = true
+
+		numeric := attr.Type == models.AttributeInt || attr.Type == models.AttributeFloat
+		switch attr.Type {
+		case models.AttributeInt, models.AttributeFloat, models.AttributeString, models.AttributeBool:
+			if len(attr.Values) > 0 {
+				return fmt.Errorf("attribute %s: values are allowed only for enum", attr.Name)
+			}
+		case models.AttributeEnum:
+			if len(attr.Values) == 0 {
+				return fmt.Errorf("attribute %s: enum needs values", attr.Name)
+			}
+			for _, value := range attr.Values {
+				if !ValidTextContent(value, maxAttributeStringLength) {
+					return fmt.Errorf("attribute %s: invalid enum value %q", attr.Name, value)
+				}
+			}
+		default:
+			return fmt.Errorf("attribute %s: unknown type %q", attr.Name, attr.Type)
+		}
+
+		if !numeric && (attr.Min != nil || attr.Max != nil) {
+			return fmt.Errorf("attribute %s: min and max are allowed only for numbers", attr.Name)
+		}
+		if attr.Min != nil && attr.Max != nil && *attr.Min > *attr.Max {
+			return fmt.Errorf("attribute %s: min is greater than max", attr.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateAttributes checks attribute values of an ad against the schema of
+// its category. Numbers come as float64 like after decoding JSON.
+func ValidateAttributes(schema models.AttributeSchemaList, attrs map[string]interface{}) error {
+	for name := range attrs {
+		if !schema.Has(name) {
+			return fmt.Errorf("unknown attribute %s", name)
+		}
+	}
+
+	for _, attr := range schema {
+		value, ok := attrs[attr.Name]
+		if !ok || value == nil {
+			if attr.Required {
+				return fmt.Errorf("attribute %s is required", attr.Name)
+			}
+			continue
+		}
+		if err := validAttributeValue(attr, value); err != nil {
+			return fmt.Errorf("attribute %s: %w", attr.Name, err)
+		}
+	}
+	return nil
+}
+
+func validAttributeValue(attr models.AttributeSchema, value interface{}) error {
+	switch attr.Type {
+	case models.AttributeInt, models.AttributeFloat:
+		number, ok := value.(float64)
+		if !ok {
+			return errors.New("must be a number")
+		}
+		if attr.Type == models.AttributeInt && number != math.Trunc(number) {
+			return errors.New("must be an integer")
+		}
+		if attr.Min != nil && number < *attr.Min {
+			return fmt.Errorf("must be at least %v", *attr.Min)
+		}
+		if attr.Max != nil && number > *attr.Max {
+			return fmt.Errorf("must be at most %v", *attr.Max)
+		}
+	case models.AttributeString:
+		s, ok := value.(string)
+		if !ok || !ValidTextContent(s, maxAttributeStringLength) {
+			return errors.New("must be a valid string")
+		}
+	case models.AttributeBool:
+		if _, ok := value.(bool); !ok {
+			return errors.New("must be true or false")
+		}
+	case models.AttributeEnum:
+		s, _ := value.(string)
+		for _, option := range attr.Values {
+			if s == option {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s", strings.Join(attr.Values, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/K1tten2005/go_vk_intern/internal/models"
+)
+
+func float(v float64) *float64 {
+	return &v
+}
+
+func TestValidateAttributeSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  models.AttributeSchemaList
+		wantErr bool
+	}{
+		{"valid", models.AttributeSchemaList{
+			{Name: "year", Type: models.AttributeInt, Required: true, Min: float(1900), Max: float(2100)},
+			{Name: "fuel", Type: models.AttributeEnum, Values: []string{"бензин", "дизель"}},
+		}, false},
+		{"invalid name", models.AttributeSchemaList{{Name: "Year", Type: models.AttributeInt}}, true},
+		{"name starts with digit", models.AttributeSchemaList{{Name: "4wd", Type: models.AttributeBool}}, true},
+		{"duplicate name", models.AttributeSchemaList{
+			{Name: "year", Type: models.AttributeInt},
+			{Name: "year", Type: models.AttributeFloat},
+		}, true},
+		{"unknown type", models.AttributeSchemaList{{Name: "year", Type: "date"}}, true},
+		{"enum without values", models.AttributeSchemaList{{Name: "fuel", Type: models.AttributeEnum}}, true},
+		{"range on string", models.AttributeSchemaList{{Name: "color", Type: models.AttributeString, Min: float(1)}}, true},
+		{"min above max", models.AttributeSchemaList{{Name: "rooms", Type: models.AttributeInt, Min: float(5), Max: float(1)}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttributeSchema(tt.schema)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAttributeSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAttributes(t *testing.T) {
+	schema := models.AttributeSchemaList{
+		{Name: "rooms", Type: models.AttributeInt, Required: true, Min: float(1), Max: float(20)},
+		{Name: "area", Type: models.AttributeFloat},
+		{Name: "balcony", Type: models.AttributeBool},
+		{Name: "district", Type: models.AttributeString},
+		{Name: "house_type", Type: models.AttributeEnum, Values: []string{"кирпичный", "панельный"}},
+	}
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr bool
+	}{
+		{"valid", map[string]interface{}{
+			"rooms": 2.0, "area": 54.5, "balcony": true, "district": "Центральный", "house_type": "панельный",
+		}, false},
+		{"only required", map[string]interface{}{"rooms": 3.0}, false},
+		{"missing required", map[string]interface{}{"area": 54.5}, true},
+		{"unknown attribute", map[string]interface{}{"rooms": 2.0, "floor": 3.0}, true},
+		{"fractional int", map[string]interface{}{"rooms": 2.5}, true},
+		{"out of range", map[string]interface{}{"rooms": 25.0}, true},
+		{"string for number", map[string]interface{}{"rooms": "2"}, true},
+		{"number for bool", map[string]interface{}{"rooms": 2.0, "balcony": 1.0}, true},
+		{"unknown enum value", map[string]interface{}{"rooms": 2.0, "house_type": "деревянный"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttributes(schema, tt.attrs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAttributes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxTagsPerAd = 10
+	minTagLength = 2
+	maxTagLength = 30
+)
+
+// NormalizeTag brings a tag to the stored form: lower case, without a
+// leading #, with single spaces between words. It reports false for tags
+// that are too short or too long or contain anything but letters, digits,
+// spaces, hyphens and underscores.
+func NormalizeTag(tag string) (string, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	tag = strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+
+	if n := utf8.RuneCountInString(tag); n < minTagLength || n > maxTagLength {
+		return "", false
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ' ' && r != '-' && r != '_' {
+			return "", false
+		}
+	}
+	return tag, true
+}
+
+// NormalizeTags normalizes tags of an ad and drops duplicates keeping the
+// first occurrence. A nil slice stays nil, so PATCH can tell "no tags" from
+// "tags not sent".
+func NormalizeTags(tags []string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		normalized, ok := NormalizeTag(tag)
+		if !ok {
+			return nil, errors.New("invalid tag " + tag)
+		}
+		if !seen[normalized] {
+			seen[normalized] = true
+			result = append(result, normalized)
+		}
+	}
+
+	if len(result) > MaxTagsPerAd {
+		return nil, errors.New("too many tags")
+	}
+	return result, nil
+}
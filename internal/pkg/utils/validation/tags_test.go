@@ -0,0 +1,37 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    []string
+		want    []string
+		wantErr bool
+	}{
+		{"nil", nil, nil, false},
+		{"empty", []string{}, []string{}, false},
+		{"normalized", []string{" #Торг ", "Новый  Год", "iphone-15"}, []string{"торг", "новый год", "iphone-15"}, false},
+		{"duplicates", []string{"Торг", "#торг", "ТОРГ"}, []string{"торг"}, false},
+		{"too short", []string{"a"}, nil, true},
+		{"too long", []string{"abcdefghijklmnopqrstuvwxyzabcde"}, nil, true},
+		{"forbidden chars", []string{"<b>"}, nil, true},
+		{"too many", []string{"a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8", "a9", "a10", "a11"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTags(tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NormalizeTags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeTags() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
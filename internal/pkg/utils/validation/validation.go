@@ -4,14 +4,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"net/url"
 	"strings"
-	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/utils/fetcher"
+	"github.com/satori/uuid"
 	"golang.org/x/crypto/argon2"
 )
 
@@ -23,8 +23,15 @@ const (
 	maxTitleLength       = 100
 	maxDescriptionLength = 700
 	maxImageURLLength    = 300
+	maxCategoryLength    = 100
+	maxCityLength        = 100
+	maxSearchNameLength  = 100
+	maxMessageLength     = 1000
+	MaxRadiusKm          = 1000
+	MaxImagesPerAd       = 10
 	MaxPrice             = 100000000
-	maxImageSizeBytes    = 10 * 1024 * 1024
+	MaxSearchQueryLength = 200
+	MaxImageSizeBytes    = 10 * 1024 * 1024
 	allowedChars         = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-"
 
 	allowedSymbolsForText = "абвгдеёжзийклмнопрстуфхцчшщъыьэюя" +
@@ -64,68 +71,135 @@ func ValidDescription(desc string) bool {
 	return ValidTextContent(desc, maxDescriptionLength)
 }
 
-func ValidImageURL(link string) bool {
-	if len(link) == 0 || len(link) > maxImageURLLength {
-		return false
-	}
+func ValidCategoryName(name string) bool {
+	return ValidTextContent(name, maxCategoryLength)
+}
 
-	u, err := url.ParseRequestURI(link)
-	if err != nil || u.Scheme == "" || u.Host == "" {
-		return false
-	}
+func ValidCity(city string) bool {
+	return ValidTextContent(city, maxCityLength)
+}
 
-	client := http.Client{Timeout: 5 * time.Second}
-	resp, err := client.Get(link)
-	if err != nil {
-		fmt.Println("GET request failed:", err)
-		return false
-	}
-	defer resp.Body.Close()
+func ValidSearchName(name string) bool {
+	return ValidTextContent(name, maxSearchNameLength)
+}
 
-	head := make([]byte, 512)
-	n, err := io.ReadFull(io.LimitReader(resp.Body, 512), head)
-	if err != nil && err != io.ErrUnexpectedEOF {
-		fmt.Println("Error reading image head:", err)
+// ValidMessage reports whether text is a non-blank chat message of at most
+// maxMessageLength characters. Messages are free text, only control
+// characters other than line breaks are rejected.
+func ValidMessage(text string) bool {
+	if strings.TrimSpace(text) == "" || !utf8.ValidString(text) || utf8.RuneCountInString(text) > maxMessageLength {
 		return false
 	}
-
-	contentType := http.DetectContentType(head[:n])
-	switch contentType {
-	case "image/jpeg", "image/png", "image/webp":
-		return true
-	default:
-		fmt.Println("Invalid image content type:", contentType)
-		return false
+	for _, r := range text {
+		if unicode.IsControl(r) && r != '\n' {
+			return false
+		}
 	}
+	return true
 }
 
-func ImageSizeUnderLimit(link string) bool {
-	client := http.Client{
-		Timeout: 10 * time.Second,
-	}
+// ValidCoordinates reports whether lat and lon form a point on the Earth.
+func ValidCoordinates(lat, lon float64) bool {
+	return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
 
-	resp, err := client.Get(link)
-	if err != nil {
-		fmt.Println(err.Error())
-		return false
+// ValidateLocation checks the optional location of an ad: the city may be
+// empty, coordinates must be given both or none.
+func ValidateLocation(city string, lat, lon *float64) error {
+	if city != "" && !ValidCity(city) {
+		return errors.New("invalid city")
+	}
+	if (lat == nil) != (lon == nil) {
+		return errors.New("latitude and longitude must be set together")
+	}
+	if lat != nil && !ValidCoordinates(*lat, *lon) {
+		return errors.New("invalid coordinates")
 	}
-	defer resp.Body.Close()
+	return nil
+}
 
-	limited := io.LimitReader(resp.Body, maxImageSizeBytes+1)
+// RemoteImageTypes are the content types accepted for images hosted
+// elsewhere.
+var RemoteImageTypes = []string{"image/jpeg", "image/png", "image/webp"}
 
-	n, err := io.Copy(io.Discard, limited)
-	if err != nil {
-		fmt.Printf("Image size for %s is %d bytes\n", link, n)
+// ValidImageLink checks the form of a link to a remote image without
+// downloading it.
+func ValidImageLink(link string) bool {
+	if len(link) == 0 || len(link) > maxImageURLLength {
 		return false
 	}
+	u, err := url.Parse(link)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Hostname() != ""
+}
 
-	return n <= int64(maxImageSizeBytes)
+// ValidateImages checks the gallery of a new ad. Uploaded images were
+// checked on upload, remote ones are only checked for the form of the link
+// here and downloaded later in the background.
+func ValidateImages(images models.AdImageList) error {
+	if len(images) == 0 {
+		return errors.New("at least one image is required")
+	}
+	if len(images) > MaxImagesPerAd {
+		return errors.New("too many images")
+	}
+	for i, image := range images {
+		if image.ImageId != nil {
+			continue
+		}
+		if !ValidImageLink(image.URL) {
+			return fmt.Errorf("image %d: %w", i+1, fetcher.ErrInvalidURL)
+		}
+	}
+	return nil
 }
 
 func ValidPrice(price int) bool {
 	return price >= 0 && price <= MaxPrice
 }
 
+// nonCentesimalCurrencies are the ISO 4217 codes whose minor unit is not a
+// hundredth of the major one. Prices are kept and formatted with two
+// decimals, so these currencies are not accepted.
+var nonCentesimalCurrencies = map[string]bool{
+	"BIF": true, "CLP": true, "DJF": true, "GNF": true, "ISK": true, "JPY": true,
+	"KMF": true, "KRW": true, "PYG": true, "RWF": true, "UGX": true, "UYI": true,
+	"VND": true, "VUV": true, "XAF": true, "XOF": true, "XPF": true,
+	"BHD": true, "IQD": true, "JOD": true, "KWD": true, "LYD": true, "OMR": true,
+	"TND": true, "CLF": true, "UYW": true,
+}
+
+// ValidCurrency reports whether code looks like an ISO 4217 code of a
+// currency with two decimals. Whether the currency is known is decided by
+// the exchange rates.
+func ValidCurrency(code string) bool {
+	if len(code) != 3 || nonCentesimalCurrencies[code] {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// maxRateDigits is the number of digits on each side of the dot which
+// exchange_rates.rate can hold.
+const maxRateDigits = 10
+
+// ValidExchangeRate reports whether rate is a positive decimal number which
+// is stored without rounding.
+func ValidExchangeRate(rate string) bool {
+	whole, frac, hasDot := strings.Cut(rate, ".")
+	if whole == "" || len(whole) > maxRateDigits || len(frac) > maxRateDigits || (hasDot && frac == "") {
+		return false
+	}
+	if strings.Trim(whole+frac, "0123456789") != "" {
+		return false
+	}
+	return strings.Trim(whole+frac, "0") != ""
+}
+
 func ValidateAd(ad models.Ad) error {
 	if !ValidTitle(ad.Title) {
 		return errors.New("invalid title")
@@ -133,16 +207,16 @@ func ValidateAd(ad models.Ad) error {
 	if !ValidDescription(ad.Description) {
 		return errors.New("invalid description")
 	}
-	if !ValidImageURL(ad.ImageURL) {
-		return errors.New("invalid image url")
-	}
-	if !ImageSizeUnderLimit(ad.ImageURL) {
-		return errors.New("image size exceeds limit")
-	}
 	if !ValidPrice(ad.Price) {
 		return errors.New("invalid price")
 	}
-	return nil
+	if ad.Currency != "" && !ValidCurrency(ad.Currency) {
+		return errors.New("invalid currency")
+	}
+	if ad.CategoryId == uuid.Nil {
+		return errors.New("category is required")
+	}
+	return ValidateLocation(ad.City, ad.Latitude, ad.Longitude)
 }
 
 func ValidLogin(login string) bool {
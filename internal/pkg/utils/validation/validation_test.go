@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/K1tten2005/go_vk_intern/internal/models"
+	"github.com/satori/uuid"
 )
 
 func TestHashPasswordAndCheckPassword(t *testing.T) {
@@ -98,50 +99,127 @@ func TestValidDescription(t *testing.T) {
 	}
 }
 
-func TestValidImageURL(t *testing.T) {
+func TestValidPrice(t *testing.T) {
+	tests := []struct {
+		name  string
+		price int
+		want  bool
+	}{
+		{"negative", -1, false},
+		{"zero", 0, true},
+		{"normal", 1000, true},
+		{"max", MaxPrice, true},
+		{"over max", MaxPrice + 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidPrice(tt.price); got != tt.want {
+				t.Errorf("ValidPrice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidCurrency(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"RUB", true},
+		{"USD", true},
+		{"usd", false},
+		{"US", false},
+		{"USDT", false},
+		{"", false},
+		{"JPY", false},
+		{"KWD", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := ValidCurrency(tt.code); got != tt.want {
+				t.Errorf("ValidCurrency() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidMessage(t *testing.T) {
 	tests := []struct {
 		name string
-		url  string
+		text string
 		want bool
 	}{
-		{"empty url", "", false},
-		{"too long", "http://example.com/" + strings.Repeat("a", maxImageURLLength), false},
-		{"invalid url", "not a url", false},
-		{"no scheme", "example.com/image.jpg", false},
-		{"no host", "http:///image.jpg", false},
-		{"valid jpg", "http://example.com/image.jpg", true},
-		{"valid jpeg", "https://example.com/image.jpeg", true},
-		{"valid png", "http://example.com/image.png", true},
-		{"valid webp", "https://example.com/image.webp", true},
-		{"invalid extension", "http://example.com/image.gif", false},
+		{"plain", "Здравствуйте! Ещё продаёте?", true},
+		{"multiline", "Добрый день.\nМожно посмотреть завтра?", true},
+		{"blank", " \n ", false},
+		{"control character", "hi\x00", false},
+		{"too long", strings.Repeat("я", maxMessageLength+1), false},
+		{"longest", strings.Repeat("я", maxMessageLength), true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := ValidImageURL(tt.url); got != tt.want {
-				t.Errorf("ValidImageURL() = %v, want %v", got, tt.want)
+			if got := ValidMessage(tt.text); got != tt.want {
+				t.Errorf("ValidMessage() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestValidPrice(t *testing.T) {
+func TestValidExchangeRate(t *testing.T) {
 	tests := []struct {
-		name  string
-		price int
-		want  bool
+		rate string
+		want bool
 	}{
-		{"negative", -1, false},
-		{"zero", 0, true},
-		{"normal", 1000, true},
-		{"max", MaxPrice, true},
-		{"over max", MaxPrice + 1, false},
+		{"1", true},
+		{"92.5", true},
+		{"0.0107", true},
+		{"0", false},
+		{"0.000", false},
+		{"-1", false},
+		{"1e2", false},
+		{"1.", false},
+		{".5", false},
+		{"0.00000000001", false},
+		{"12345678901", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rate, func(t *testing.T) {
+			if got := ValidExchangeRate(tt.rate); got != tt.want {
+				t.Errorf("ValidExchangeRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateLocation(t *testing.T) {
+	coord := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name    string
+		city    string
+		lat     *float64
+		lon     *float64
+		wantErr bool
+	}{
+		{"no location", "", nil, nil, false},
+		{"city only", "Москва", nil, nil, false},
+		{"full", "Санкт-Петербург", coord(59.93), coord(30.31), false},
+		{"edges", "", coord(-90), coord(180), false},
+		{"invalid city", "<Москва>", nil, nil, true},
+		{"latitude only", "", coord(55.75), nil, true},
+		{"longitude only", "", nil, coord(37.62), true},
+		{"latitude out of range", "", coord(90.5), coord(37.62), true},
+		{"longitude out of range", "", coord(55.75), coord(-181), true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := ValidPrice(tt.price); got != tt.want {
-				t.Errorf("ValidPrice() = %v, want %v", got, tt.want)
+			if err := ValidateLocation(tt.city, tt.lat, tt.lon); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLocation() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
@@ -151,8 +229,8 @@ func TestValidateAd(t *testing.T) {
 	validAd := models.Ad{
 		Title:       "Valid Title",
 		Description: "Valid description with some text",
-		ImageURL:    "http://example.com/image.jpg",
 		Price:       1000,
+		CategoryId:  uuid.NewV4(),
 	}
 
 	tests := []struct {
@@ -163,8 +241,9 @@ func TestValidateAd(t *testing.T) {
 		{"valid", func(a *models.Ad) {}, nil},
 		{"invalid title", func(a *models.Ad) { a.Title = "" }, errors.New("invalid title")},
 		{"invalid description", func(a *models.Ad) { a.Description = "" }, errors.New("invalid description")},
-		{"invalid image url", func(a *models.Ad) { a.ImageURL = "invalid" }, errors.New("invalid image url")},
 		{"invalid price", func(a *models.Ad) { a.Price = -1 }, errors.New("invalid price")},
+		{"invalid currency", func(a *models.Ad) { a.Currency = "usd" }, errors.New("invalid currency")},
+		{"missing category", func(a *models.Ad) { a.CategoryId = uuid.Nil }, errors.New("category is required")},
 	}
 
 	for _, tt := range tests {
@@ -184,6 +263,35 @@ func TestValidateAd(t *testing.T) {
 	}
 }
 
+func TestValidateImages(t *testing.T) {
+	uploadedId := uuid.NewV4()
+	tests := []struct {
+		name    string
+		images  models.AdImageList
+		wantErr string
+	}{
+		{"empty", nil, "at least one image is required"},
+		{"too many", make(models.AdImageList, MaxImagesPerAd+1), "too many images"},
+		{"invalid item", models.AdImageList{{ImageId: &uploadedId}, {URL: "invalid"}}, "image 2: invalid image url"},
+		{"uploaded only", models.AdImageList{{ImageId: &uploadedId}}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateImages(tt.images)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateImages() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateImages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidLogin(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/middleware/logger"
+)
+
+// RunPeriodic calls job once per interval until ctx is cancelled. The job
+// gets a context carrying loggerVar, so repo and usecase logs of background
+// jobs end up in the same place as request logs.
+func RunPeriodic(ctx context.Context, loggerVar *slog.Logger, name string, interval time.Duration, job func(ctx context.Context) error) {
+	RunTriggered(ctx, loggerVar, name, interval, nil, job)
+}
+
+// RunTriggered is RunPeriodic which also runs job as soon as trigger
+// receives a value, without waiting for the rest of the interval.
+func RunTriggered(ctx context.Context, loggerVar *slog.Logger, name string, interval time.Duration, trigger <-chan struct{}, job func(ctx context.Context) error) {
+	loggerVar = loggerVar.With(slog.String("worker", name))
+	ctx = context.WithValue(ctx, logger.LoggerKey, loggerVar)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	loggerVar.Info("Worker started")
+	for {
+		if err := job(ctx); err != nil {
+			loggerVar.Error("job failed: " + err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			loggerVar.Info("Worker stopped")
+			return
+		case <-ticker.C:
+		case <-trigger:
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/go_vk_intern/internal/pkg/middleware/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunPeriodic(t *testing.T) {
+	loggerVar := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{}))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		RunPeriodic(ctx, loggerVar, "test", 10*time.Millisecond, func(ctx context.Context) error {
+			assert.NotNil(t, ctx.Value(logger.LoggerKey))
+			if calls.Add(1) == 2 {
+				return errors.New("job error")
+			}
+			return nil
+		})
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, 5*time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RunPeriodic did not stop after cancel")
+	}
+}
+
+func TestRunTriggered(t *testing.T) {
+	loggerVar := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	trigger := make(chan struct{})
+	go RunTriggered(ctx, loggerVar, "test", time.Hour, trigger, func(ctx context.Context) error {
+		calls.Add(1)
+		return nil
+	})
+
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, 5*time.Millisecond)
+	trigger <- struct{}{}
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, 5*time.Millisecond)
+}